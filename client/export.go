@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"github.com/gin-gonic/gin"
+)
+
+var exportContentTypes = map[string]string{
+	"csv":   "text/csv; charset=utf-8",
+	"jsonl": "application/x-ndjson",
+}
+
+// exportDownload streams an export of a table as a file download. The query
+// takes the filter and order_by of the table's list route, and format, csv
+// (the default) or jsonl.
+func exportDownload(ctx *gin.Context, client pb.RMSServiceClient, table, entity string) {
+	var query ExportQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+	if query.Format == "" {
+		query.Format = "csv"
+	}
+	stream, err := client.ExportRecords(ctx, &pb.ExportRecordsRequest{
+		Entity:  entity,
+		Format:  query.Format,
+		Filter:  query.Filter,
+		OrderBy: query.OrderBy,
+	})
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	// Errors in the first chunk, such as a bad filter, still get an error
+	// response; later ones can only cut the download short.
+	chunk, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		writeError(ctx, err)
+		return
+	}
+	ctx.Header("Content-Type", exportContentTypes[query.Format])
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", table+"."+query.Format))
+	ctx.Status(http.StatusOK)
+	for err == nil {
+		if _, err := ctx.Writer.Write(chunk.GetData()); err != nil {
+			return
+		}
+		ctx.Writer.Flush()
+		chunk, err = stream.Recv()
+	}
+	if !errors.Is(err, io.EOF) {
+		log.Printf("export of %s cut short: %v", table, err)
+	}
+}
+
+// citationFormats are the content type and file extension of each citation
+// format.
+var citationFormats = map[string]struct{ contentType, ext string }{
+	"bibtex":   {"application/x-bibtex; charset=utf-8", "bib"},
+	"ris":      {"application/x-research-info-systems; charset=utf-8", "ris"},
+	"csl-json": {"application/vnd.citationstyles.csl+json", "json"},
+}
+
+// citationsDownload serves the citations of publications as a file download.
+// The query names them by repeated id parameters, or else by the filter and
+// order_by of the list route, and takes format, bibtex (the default), ris or
+// csl-json.
+func citationsDownload(ctx *gin.Context, client pb.RMSServiceClient) {
+	var query CitationsQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+	if query.Format == "" {
+		query.Format = "bibtex"
+	}
+	res, err := client.ExportCitations(ctx, &pb.ExportCitationsRequest{
+		Format:         query.Format,
+		PublicationIds: query.IDs,
+		Filter:         query.Filter,
+		OrderBy:        query.OrderBy,
+	})
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+	f := citationFormats[query.Format]
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "publications."+f.ext))
+	ctx.Data(http.StatusOK, f.contentType, []byte(res.Citations))
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// importFormat returns the format of an imported file: the one asked for, or
+// else the one its extension names.
+func importFormat(format, name string) (string, error) {
+	if format != "" {
+		return format, nil
+	}
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return "csv", nil
+	case ".jsonl", ".ndjson":
+		return "jsonl", nil
+	}
+	return "", fmt.Errorf("cannot tell the format of %q from its extension; give it as csv or jsonl", name)
+}
+
+// runImport imports a CSV or JSON Lines file of IP assets or publications:
+//
+//	client import [-format csv|jsonl] [-map mapping.json] [-dry-run] [-upsert] [-mode best_effort] ip_asset|publication FILE
+//
+// mapping.json maps the file's columns to fields, e.g. {"Title": "title_of_work"}.
+// The access token is read from -token or RMS_TOKEN.
+func runImport(client pb.RMSServiceClient, args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	format := fs.String("format", "", "file format, csv or jsonl; defaults to the file's extension")
+	mapPath := fs.String("map", "", "JSON file mapping columns to fields")
+	dryRun := fs.Bool("dry-run", false, "check and report every row without writing anything")
+	upsert := fs.Bool("upsert", false, "update the records whose key a row holds")
+	mode := fs.String("mode", "", "all_or_nothing (the default) or best_effort")
+	token := fs.String("token", os.Getenv("RMS_TOKEN"), "access token (env RMS_TOKEN)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return errors.New("usage: client import [flags] ip_asset|publication FILE")
+	}
+	entity, path := fs.Arg(0), fs.Arg(1)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	req := &pb.ImportRecordsRequest{
+		Entity: entity,
+		Data:   data,
+		DryRun: *dryRun,
+		Upsert: *upsert,
+	}
+	if req.Format, err = importFormat(*format, path); err != nil {
+		return err
+	}
+	if req.Mode, err = batchMode(*mode); err != nil {
+		return err
+	}
+	if *mapPath != "" {
+		mapping, err := os.ReadFile(*mapPath)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(mapping, &req.ColumnMapping); err != nil {
+			return fmt.Errorf("parsing %s: %w", *mapPath, err)
+		}
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+*token)
+	res, err := client.ImportRecords(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, r := range res.Results {
+		if st := status.FromProto(r.Status); st.Code() != codes.OK {
+			fmt.Fprintf(w, "row %d: failed: %s\n", r.Row, st.Message())
+			continue
+		}
+		fmt.Fprintf(w, "row %d: %s %s %s\n", r.Row, r.Action, entity, r.Id)
+	}
+	fmt.Fprintf(w, "%d created, %d updated, %d failed\n", res.Created, res.Updated, res.Failed)
+	if res.RolledBack {
+		fmt.Fprintln(w, "Nothing was written.")
+	}
+	if res.Failed > 0 {
+		return fmt.Errorf("%d rows failed", res.Failed)
+	}
+	return nil
+}
+
+// importUpload imports the file of a multipart upload to a :import route. The
+// form holds the file as "file" and optionally "format", "mapping", a JSON
+// object of columns to fields, "dry_run", "upsert" and "mode".
+func importUpload(ctx *gin.Context, client pb.RMSServiceClient, entity string) {
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	req := &pb.ImportRecordsRequest{Entity: entity, Data: data}
+	if req.Format, err = importFormat(ctx.PostForm("format"), header.Filename); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+	if req.Mode, err = batchMode(ctx.PostForm("mode")); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+	if mapping := ctx.PostForm("mapping"); mapping != "" {
+		if err := json.Unmarshal([]byte(mapping), &req.ColumnMapping); err != nil {
+			writeError(ctx, invalidRequest(fmt.Errorf("mapping: %w", err)))
+			return
+		}
+	}
+	for name, v := range map[string]*bool{"dry_run": &req.DryRun, "upsert": &req.Upsert} {
+		if form := ctx.PostForm(name); form != "" {
+			if *v, err = strconv.ParseBool(form); err != nil {
+				writeError(ctx, invalidRequest(fmt.Errorf("%s: %w", name, err)))
+				return
+			}
+		}
+	}
+
+	res, err := client.ImportRecords(ctx, req)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+	results := []gin.H{}
+	for _, r := range res.Results {
+		result := gin.H{"row": r.Row, "action": r.Action, "id": r.Id}
+		if st := status.FromProto(r.Status); st.Code() != codes.OK {
+			result["error"] = errorBody(httpStatus(st.Code()), st)
+		}
+		results = append(results, result)
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"results":     results,
+		"created":     res.Created,
+		"updated":     res.Updated,
+		"failed":      res.Failed,
+		"rolled_back": res.RolledBack,
+	})
+}
+
+// citationsFormat returns the format of an uploaded citations file: the one
+// asked for, or else the one its extension names.
+func citationsFormat(format, name string) (string, error) {
+	if format != "" {
+		return format, nil
+	}
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".bib", ".bibtex":
+		return "bibtex", nil
+	case ".ris":
+		return "ris", nil
+	}
+	return "", fmt.Errorf("cannot tell the format of %q from its extension; give it as bibtex or ris", name)
+}
+
+// citationsUpload imports the BibTeX or RIS file of a multipart upload to
+// the :importCitations route as draft publications. The form holds the file
+// as "file" and optionally "format" and "dry_run".
+func citationsUpload(ctx *gin.Context, client pb.RMSServiceClient) {
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	req := &pb.ImportCitationsRequest{Data: data}
+	if req.Format, err = citationsFormat(ctx.PostForm("format"), header.Filename); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+	if form := ctx.PostForm("dry_run"); form != "" {
+		if req.DryRun, err = strconv.ParseBool(form); err != nil {
+			writeError(ctx, invalidRequest(fmt.Errorf("dry_run: %w", err)))
+			return
+		}
+	}
+
+	res, err := client.ImportCitations(ctx, req)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+	results := []gin.H{}
+	for _, r := range res.Results {
+		result := gin.H{"line": r.Line, "key": r.Key, "title": r.Title, "action": r.Action, "publication_id": r.PublicationId}
+		if st := status.FromProto(r.Status); st.Code() != codes.OK {
+			result["reason"] = errorBody(httpStatus(st.Code()), st)
+		}
+		results = append(results, result)
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"results": results,
+		"created": res.Created,
+		"skipped": res.Skipped,
+	})
+}
@@ -1,15 +1,32 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	pb "example.com/go-grpc-crud-api/proto"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/genproto/googleapis/type/date"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 var (
@@ -23,6 +40,7 @@ type Author struct {
 	TypeofAuthor string `json:"type_of_author"`
 	Affiliation  string `json:"affiliation"`
 	AuthorEmail  string `json:"email"`
+	Version      int64  `json:"version"`
 }
 
 type IP_Asset struct {
@@ -30,8 +48,8 @@ type IP_Asset struct {
 	TitleOfWork        string `json:"title_of_work"`
 	TypeOfDocument     string `json:"type_of_document"`
 	ClassOfWork        string `json:"class_of_work"`
-	DateOfCreation     string `json:"date_of_creation"`
-	DateRegistered     string `json:"date_registered"`
+	DateOfCreation     Date   `json:"date_of_creation"`
+	DateRegistered     Date   `json:"date_registered"`
 	Campus             string `json:"campus"`
 	College            string `json:"college"`
 	Program            string `json:"program"`
@@ -39,11 +57,12 @@ type IP_Asset struct {
 	Hyperlink          string `json:"hyperlink"`
 	Status             string `json:"status"`
 	Certificate        string `json:"certificate"`
+	Version            int64  `json:"version"`
 }
 
 type Publication struct {
 	PublicationID        string `json:"publication_id"`
-	DatePublished        string `json:"date_published"`
+	DatePublished        Date   `json:"date_published"`
 	Quartile             string `json:"quartile"`
 	Authors              string `json:"authors"`
 	Department           string `json:"department"`
@@ -59,6 +78,8 @@ type Publication struct {
 	NatureOfFunding      string `json:"nature_of_funding"`
 	Publisher            string `json:"publisher"`
 	Abstract             string `json:"abstract"`
+	Draft                bool   `json:"draft"`
+	Version              int64  `json:"version"`
 }
 
 type User struct {
@@ -72,19 +93,459 @@ type User struct {
 	UserFname   string `json:"user_fname"`
 	UserLname   string `json:"user_lname"`
 	UserMname   string `json:"user_mname"`
+	Version     int64  `json:"version"`
 }
 
 type Log struct {
 	LogID       string `json:"log_id"`
-	DateTime    string `json:"date_time"`
 	UserID      int    `json:"user_id"`
 	Activity    string `json:"activity"`
 	Description string `json:"description"`
+	Entity      string `json:"entity"`
+	EntityID    string `json:"entity_id"`
+}
+
+type AuthorLink struct {
+	AuthorID    string `json:"author_id"`
+	AuthorOrder int32  `json:"author_order"`
+	Role        string `json:"role"`
+}
+
+type VocabularyTerm struct {
+	Value    string `json:"value"`
+	Label    string `json:"label"`
+	Position int32  `json:"position"`
+}
+
+type Login struct {
+	Login    string `json:"login"`
+	Password string `json:"password"`
+}
+
+type ListQuery struct {
+	PageSize         int32  `form:"page_size"`
+	PageToken        string `form:"page_token"`
+	IncludeTotalSize bool   `form:"include_total_size"`
+	Filter           string `form:"filter"`
+	OrderBy          string `form:"order_by"`
+}
+
+type SearchQuery struct {
+	Q        string `form:"q"`
+	PageSize int32  `form:"page_size"`
+}
+
+// Date is a date in a request body, in ISO 8601: "2021-06-30", or "2021-06"
+// and "2021" for dates known only to the month or year. JSON bodies may also
+// hold the {"year", "month", "day"} object responses render dates as.
+type Date string
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*d = Date(s)
+		return nil
+	}
+	var v struct {
+		Year  int32 `json:"year"`
+		Month int32 `json:"month"`
+		Day   int32 `json:"day"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return errors.New("a date must be an ISO 8601 string or a {year, month, day} object")
+	}
+	switch {
+	case v.Year == 0:
+		*d = ""
+	case v.Month == 0:
+		*d = Date(fmt.Sprintf("%04d", v.Year))
+	case v.Day == 0:
+		*d = Date(fmt.Sprintf("%04d-%02d", v.Year, v.Month))
+	default:
+		*d = Date(fmt.Sprintf("%04d-%02d-%02d", v.Year, v.Month, v.Day))
+	}
+	return nil
+}
+
+// violations collects the invalid fields of a request body, which the
+// gateway reports like the server reports invalid requests.
+type violations []*errdetails.BadRequest_FieldViolation
+
+// date parses the date at field, returning nil when it is empty.
+func (v *violations) date(field string, d Date) *date.Date {
+	if d == "" {
+		return nil
+	}
+	for _, layout := range []string{"2006-01-02", "2006-01", "2006"} {
+		t, err := time.Parse(layout, string(d))
+		if err != nil {
+			continue
+		}
+		res := &date.Date{Year: int32(t.Year())}
+		if len(layout) >= len("2006-01") {
+			res.Month = int32(t.Month())
+		}
+		if len(layout) == len("2006-01-02") {
+			res.Day = int32(t.Day())
+		}
+		return res
+	}
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: "must be an ISO 8601 date: YYYY-MM-DD, YYYY-MM or YYYY",
+	})
+	return nil
+}
+
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(v))
+	for i, f := range v {
+		msgs[i] = f.Field + ": " + f.Description
+	}
+	st := status.New(codes.InvalidArgument, strings.Join(msgs, "; "))
+	if d, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v}); err == nil {
+		st = d
+	}
+	return st.Err()
+}
+
+// dates parses the dates of an IP asset body.
+func (a *IP_Asset) dates() (dateOfCreation, dateRegistered *date.Date, err error) {
+	var v violations
+	dateOfCreation = v.date("ip_asset.date_of_creation", a.DateOfCreation)
+	dateRegistered = v.date("ip_asset.date_registered", a.DateRegistered)
+	return dateOfCreation, dateRegistered, v.err()
+}
+
+// dates parses the dates of a publication body.
+func (p *Publication) dates() (datePublished *date.Date, err error) {
+	var v violations
+	datePublished = v.date("publication.date_published", p.DatePublished)
+	return datePublished, v.err()
+}
+
+type VocabularyQuery struct {
+	Vocabulary string `form:"vocabulary"`
+}
+
+// The proto methods convert the records of a batch body, adding the
+// violations of their dates to v with prefix before their field paths.
+func (a *Author) proto() *pb.Author {
+	return &pb.Author{
+		AuthorId:     a.ID,
+		AuthorName:   a.AuthorName,
+		Gender:       a.AuthorGender,
+		TypeOfAuthor: a.TypeofAuthor,
+		Affiliation:  a.Affiliation,
+		Email:        a.AuthorEmail,
+		Version:      a.Version,
+	}
+}
+
+func (a *IP_Asset) proto(v *violations, prefix string) *pb.IP_Asset {
+	return &pb.IP_Asset{
+		RegistrationNumber: a.RegistartionNumber,
+		TitleOfWork:        a.TitleOfWork,
+		TypeOfDocument:     a.TypeOfDocument,
+		ClassOfWork:        a.ClassOfWork,
+		DateOfCreation:     v.date(prefix+"ip_asset.date_of_creation", a.DateOfCreation),
+		DateRegistered:     v.date(prefix+"ip_asset.date_registered", a.DateRegistered),
+		Campus:             a.Campus,
+		College:            a.College,
+		Program:            a.Program,
+		Authors:            a.Authors,
+		Hyperlink:          a.Hyperlink,
+		Status:             a.Status,
+		Certificate:        a.Certificate,
+		Version:            a.Version,
+	}
+}
+
+func (p *Publication) proto(v *violations, prefix string) *pb.Publication {
+	return &pb.Publication{
+		PublicationId:        p.PublicationID,
+		DatePublished:        v.date(prefix+"publication.date_published", p.DatePublished),
+		Quartile:             p.Quartile,
+		Authors:              p.Authors,
+		Department:           p.Department,
+		College:              p.College,
+		Campus:               p.Campus,
+		TitleOfPaper:         p.TitleOfPaper,
+		TypeOfPublication:    p.TypeOfPublication,
+		FundingSource:        p.FundingSource,
+		NumberOfCitation:     int32(p.NumberOfCitation),
+		GoogleScholarDetails: p.GoogleScholarDetails,
+		SdgNo:                p.SDGNo,
+		FundingType:          p.FundingType,
+		NatureOfFunding:      p.NatureOfFunding,
+		Publisher:            p.Publisher,
+		Abstract:             p.Abstract,
+		Draft:                p.Draft,
+		Version:              p.Version,
+	}
+}
+
+func (u *User) proto() *pb.User {
+	return &pb.User{
+		UserId:      int32(u.UserID),
+		SrCode:      u.SRCode,
+		Email:       u.Email,
+		Password:    u.Password,
+		AccountType: u.AccountType,
+		UserContact: u.UserContact,
+		UserImg:     u.UserImg,
+		UserFname:   u.UserFname,
+		UserLname:   u.UserLname,
+		UserMname:   u.UserMname,
+		Version:     u.Version,
+	}
+}
+
+// BatchBody is the body of a :batchCreate or :batchUpdate route. Mode is
+// "all_or_nothing", the default, or "best_effort". Each request is a record
+// as the single-record routes take it; for :batchUpdate it names its record
+// by key, may hold the version it expects, and only sets the fields it has,
+// as in a PATCH.
+type BatchBody struct {
+	Mode     string            `json:"mode"`
+	Requests []json.RawMessage `json:"requests"`
+}
+
+// batchMode converts the mode of a batch or an import.
+func batchMode(name string) (pb.BatchMode, error) {
+	if name == "" {
+		return pb.BatchMode_BATCH_MODE_ALL_OR_NOTHING, nil
+	}
+	mode, ok := pb.BatchMode_value["BATCH_MODE_"+strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("mode must be all_or_nothing or best_effort, not %q", name)
+	}
+	return pb.BatchMode(mode), nil
+}
+
+// batchResult renders the result of one request of a batch: its record under
+// the table name, or its error in the gateway's error shape.
+func batchResult(table string, record any, st *spb.Status) gin.H {
+	s := status.FromProto(st)
+	if s.Code() == codes.OK {
+		return gin.H{table: record}
+	}
+	return gin.H{"error": errorBody(httpStatus(s.Code()), s)}
+}
+
+// isCustomMethod reports whether the :method parameter of a table route is
+// one of methods. gin cannot route a literal colon, so the custom methods of
+// a table, e.g. POST /table_authors:batchCreate, share one route whose
+// parameter holds the colon and the method name.
+func isCustomMethod(ctx *gin.Context, methods ...string) bool {
+	for _, m := range methods {
+		if ctx.Param("method") == ":"+m {
+			return true
+		}
+	}
+	writeStatus(ctx, http.StatusNotFound, status.New(codes.NotFound, "no such method: "+ctx.Param("method")))
+	return false
+}
+
+type DiffQuery struct {
+	From int32 `form:"from" binding:"required"`
+	To   int32 `form:"to"`
+}
+
+type CitationsQuery struct {
+	Format  string   `form:"format"`
+	IDs     []string `form:"id"`
+	Filter  string   `form:"filter"`
+	OrderBy string   `form:"order_by"`
+}
+
+type ExportQuery struct {
+	Format  string `form:"format"`
+	Filter  string `form:"filter"`
+	OrderBy string `form:"order_by"`
+}
+
+// forwardAuthorization passes the Authorization header of the gateway request
+// a call is made for on to the server as gRPC metadata.
+func forwardAuthorization(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(authorizationContext(ctx), method, req, reply, cc, opts...)
+}
+
+// forwardStreamAuthorization is forwardAuthorization for streaming calls.
+func forwardStreamAuthorization(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(authorizationContext(ctx), desc, cc, method, opts...)
+}
+
+func authorizationContext(ctx context.Context) context.Context {
+	if c, ok := ctx.(*gin.Context); ok {
+		if auth := c.GetHeader("Authorization"); auth != "" {
+			return metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+		}
+	}
+	return ctx
+}
+
+// etag formats the version of a record as its ETag.
+func etag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ifMatch returns the version a write expects: the one in the If-Match
+// header, zero for "*", or fallback when there is no header. Weak ETags, such
+// as W/"3", match as their strong form. When the header holds anything but a
+// single ETag from etag it answers 412 and ok is false.
+func ifMatch(ctx *gin.Context, fallback int64) (version int64, ok bool) {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	switch header {
+	case "":
+		return fallback, true
+	case "*":
+		return 0, true
+	}
+	unquoted, err := strconv.Unquote(strings.TrimPrefix(header, "W/"))
+	if err == nil {
+		version, err = strconv.ParseInt(unquoted, 10, 64)
+	}
+	if err != nil || version <= 0 {
+		writeStatus(ctx, http.StatusPreconditionFailed, status.New(codes.FailedPrecondition, "If-Match must be an ETag of the record"))
+		return 0, false
+	}
+	return version, true
+}
+
+// httpStatus maps a gRPC code to the HTTP status the gateway answers with.
+func httpStatus(c codes.Code) int {
+	switch c {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.Aborted:
+		// The server only aborts writes whose expected version is stale.
+		return http.StatusPreconditionFailed
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+// invalidRequest wraps an error decoding a gateway request as InvalidArgument.
+func invalidRequest(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// writeError answers with err in the gateway's JSON error shape, under the
+// HTTP status its gRPC code maps to.
+func writeError(ctx *gin.Context, err error) {
+	st := status.Convert(err)
+	writeStatus(ctx, httpStatus(st.Code()), st)
+}
+
+// writeStatus answers with st in the gateway's JSON error shape:
+//
+//	{"error": {"code": 404, "status": "NOT_FOUND", "message": "...", "details": [...]}}
+//
+// details holds the google.rpc error details of st, such as BadRequest field
+// violations, each with its "@type". Field violations are also listed under
+// "fields", keyed by field path, so that forms can show them next to their
+// inputs:
+//
+//	{"error": {..., "fields": {"publication.quartile": ["must be Q1, Q2, Q3 or Q4"]}}}
+func writeStatus(ctx *gin.Context, httpCode int, st *status.Status) {
+	ctx.JSON(httpCode, gin.H{"error": errorBody(httpCode, st)})
+}
+
+// errorBody is the object under "error" in the gateway's error shape.
+func errorBody(httpCode int, st *status.Status) gin.H {
+	details := []json.RawMessage{}
+	for _, d := range st.Proto().GetDetails() {
+		if data, err := protojson.Marshal(d); err == nil {
+			details = append(details, data)
+		}
+	}
+	body := gin.H{
+		"code":    httpCode,
+		"status":  code.Code(st.Code()).String(),
+		"message": st.Message(),
+		"details": details,
+	}
+	fields := map[string][]string{}
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields[v.GetField()] = append(fields[v.GetField()], v.GetDescription())
+			}
+		}
+	}
+	if len(fields) > 0 {
+		body["fields"] = fields
+	}
+	return body
+}
+
+// bindPatch decodes a JSON PATCH body into v and returns an update mask of
+// the keys the body sets, leaving out the keys named in skip.
+func bindPatch(ctx *gin.Context, v any, skip ...string) (*fieldmaskpb.FieldMask, error) {
+	body, err := ctx.GetRawData()
+	if err != nil {
+		return nil, err
+	}
+	return patchMask(body, v, skip...)
+}
+
+// patchMask decodes a JSON object into v and returns an update mask of the
+// keys it sets, leaving out the keys named in skip.
+func patchMask(body []byte, v any, skip ...string) (*fieldmaskpb.FieldMask, error) {
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return nil, err
+	}
+	mask := &fieldmaskpb.FieldMask{}
+next:
+	for key := range keys {
+		for _, k := range skip {
+			if key == k {
+				continue next
+			}
+		}
+		mask.Paths = append(mask.Paths, key)
+	}
+	if len(mask.Paths) == 0 {
+		return nil, errors.New("the body sets no fields to update")
+	}
+	sort.Strings(mask.Paths)
+	return mask, nil
 }
 
 func main() {
 	flag.Parse()
-	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(*addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(forwardAuthorization),
+		grpc.WithStreamInterceptor(forwardStreamAuthorization),
+	)
 
 	if err != nil {
 		log.Fatalf("did not connect: %v", err)
@@ -93,30 +554,47 @@ func main() {
 	defer conn.Close()
 	client := pb.NewRMSServiceClient(conn)
 
+	if flag.Arg(0) == "import" {
+		if err := runImport(client, flag.Args()[1:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	r := gin.Default()
 
 	//table_authors
 	r.GET("/table_authors", func(ctx *gin.Context) {
-		res, err := client.GetAuthors(ctx, &pb.ReadAuthorsRequest{})
+		var query ListQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.GetAuthors(ctx, &pb.ReadAuthorsRequest{
+			PageSize:         query.PageSize,
+			PageToken:        query.PageToken,
+			IncludeTotalSize: query.IncludeTotalSize,
+			Filter:           query.Filter,
+			OrderBy:          query.OrderBy,
+		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, err)
 			return
 		}
 		ctx.JSON(http.StatusOK, gin.H{
-			"table_authors": res.Authors,
+			"table_authors":   res.Authors,
+			"next_page_token": res.NextPageToken,
+			"total_size":      res.TotalSize,
 		})
 	})
 	r.GET("/table_authors/:author_id", func(ctx *gin.Context) {
 		id := ctx.Param("author_id")
 		res, err := client.GetAuthor(ctx, &pb.ReadAuthorRequest{AuthorId: id})
 		if err != nil {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"message": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.Author.GetVersion()))
 		ctx.JSON(http.StatusOK, gin.H{
 			"table_authors": res.Author,
 		})
@@ -126,9 +604,7 @@ func main() {
 
 		err := ctx.ShouldBind(&author)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, invalidRequest(err))
 			return
 		}
 		data := &pb.Author{
@@ -143,11 +619,10 @@ func main() {
 			Author: data,
 		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.Author.GetVersion()))
 		ctx.JSON(http.StatusCreated, gin.H{
 			"": res.Author,
 		})
@@ -156,9 +631,11 @@ func main() {
 		var author Author
 		err := ctx.ShouldBind(&author)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		version, ok := ifMatch(ctx, author.Version)
+		if !ok {
 			return
 		}
 		res, err := client.UpdateAuthor(ctx, &pb.UpdateAuthorRequest{
@@ -169,14 +646,48 @@ func main() {
 				TypeOfAuthor: author.TypeofAuthor,
 				Affiliation:  author.Affiliation,
 				Email:        author.AuthorEmail,
+				Version:      version,
 			},
 		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, err)
+			return
+		}
+		ctx.Header("ETag", etag(res.Author.GetVersion()))
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_authors": res.Author,
+		})
+		return
+
+	})
+	r.PATCH("/table_authors/:author_id", func(ctx *gin.Context) {
+		var author Author
+		mask, err := bindPatch(ctx, &author, "author_id", "version")
+		if err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		version, ok := ifMatch(ctx, author.Version)
+		if !ok {
+			return
+		}
+		res, err := client.UpdateAuthor(ctx, &pb.UpdateAuthorRequest{
+			Author: &pb.Author{
+				AuthorId:     ctx.Param("author_id"),
+				AuthorName:   author.AuthorName,
+				Gender:       author.AuthorGender,
+				TypeOfAuthor: author.TypeofAuthor,
+				Affiliation:  author.Affiliation,
+				Email:        author.AuthorEmail,
+				Version:      version,
+			},
+			UpdateMask: mask,
+		})
+		if err != nil {
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.Author.GetVersion()))
 		ctx.JSON(http.StatusOK, gin.H{
 			"table_authors": res.Author,
 		})
@@ -185,11 +696,13 @@ func main() {
 	})
 	r.DELETE("/table_authors/:author_id", func(ctx *gin.Context) {
 		id := ctx.Param("author_id")
-		res, err := client.DeleteAuthor(ctx, &pb.DeleteAuthorRequest{AuthorId: id})
+		version, ok := ifMatch(ctx, 0)
+		if !ok {
+			return
+		}
+		res, err := client.DeleteAuthor(ctx, &pb.DeleteAuthorRequest{AuthorId: id, Version: version})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
 		if res.Success == true {
@@ -198,9 +711,7 @@ func main() {
 			})
 			return
 		} else {
-			ctx.JSON(http.StatusInternalServerError, gin.H{
-				"error": "error deleting author",
-			})
+			writeError(ctx, status.Error(codes.Internal, "error deleting author"))
 			return
 		}
 
@@ -208,26 +719,36 @@ func main() {
 
 	//table_ipassets
 	r.GET("/table_ipassets", func(ctx *gin.Context) {
-		res, err := client.GetIP_Assets(ctx, &pb.ReadIP_AssetsRequest{})
+		var query ListQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.GetIP_Assets(ctx, &pb.ReadIP_AssetsRequest{
+			PageSize:         query.PageSize,
+			PageToken:        query.PageToken,
+			IncludeTotalSize: query.IncludeTotalSize,
+			Filter:           query.Filter,
+			OrderBy:          query.OrderBy,
+		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, err)
 			return
 		}
 		ctx.JSON(http.StatusOK, gin.H{
-			"table_ipassets": res.IpAssets,
+			"table_ipassets":  res.IpAssets,
+			"next_page_token": res.NextPageToken,
+			"total_size":      res.TotalSize,
 		})
 	})
 	r.GET("/table_ipassets/:registration_number", func(ctx *gin.Context) {
 		id := ctx.Param("registration_number")
 		res, err := client.GetIP_Asset(ctx, &pb.ReadIP_AssetRequest{RegistrationNumber: id})
 		if err != nil {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"message": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.IpAsset.GetVersion()))
 		ctx.JSON(http.StatusOK, gin.H{
 			"table_ipassets": res.IpAsset,
 		})
@@ -237,9 +758,12 @@ func main() {
 
 		err := ctx.ShouldBind(&ipAsset)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		dateOfCreation, dateRegistered, err := ipAsset.dates()
+		if err != nil {
+			writeError(ctx, err)
 			return
 		}
 		data := &pb.IP_Asset{
@@ -247,8 +771,8 @@ func main() {
 			TitleOfWork:        ipAsset.TitleOfWork,
 			TypeOfDocument:     ipAsset.TypeOfDocument,
 			ClassOfWork:        ipAsset.ClassOfWork,
-			DateOfCreation:     ipAsset.DateOfCreation,
-			DateRegistered:     ipAsset.DateRegistered,
+			DateOfCreation:     dateOfCreation,
+			DateRegistered:     dateRegistered,
 			Campus:             ipAsset.Campus,
 			College:            ipAsset.College,
 			Program:            ipAsset.Program,
@@ -261,11 +785,10 @@ func main() {
 			IpAsset: data,
 		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.IpAsset.GetVersion()))
 		ctx.JSON(http.StatusCreated, gin.H{
 			"table_ipassets": res.IpAsset,
 		})
@@ -274,9 +797,16 @@ func main() {
 		var ipAsset IP_Asset
 		err := ctx.ShouldBind(&ipAsset)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		dateOfCreation, dateRegistered, err := ipAsset.dates()
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		version, ok := ifMatch(ctx, ipAsset.Version)
+		if !ok {
 			return
 		}
 		res, err := client.UpdateIP_Asset(ctx, &pb.UpdateIP_AssetRequest{
@@ -285,8 +815,8 @@ func main() {
 				TitleOfWork:        ipAsset.TitleOfWork,
 				TypeOfDocument:     ipAsset.TypeOfDocument,
 				ClassOfWork:        ipAsset.ClassOfWork,
-				DateOfCreation:     ipAsset.DateOfCreation,
-				DateRegistered:     ipAsset.DateRegistered,
+				DateOfCreation:     dateOfCreation,
+				DateRegistered:     dateRegistered,
 				Campus:             ipAsset.Campus,
 				College:            ipAsset.College,
 				Program:            ipAsset.Program,
@@ -294,14 +824,60 @@ func main() {
 				Hyperlink:          ipAsset.Hyperlink,
 				Status:             ipAsset.Status,
 				Certificate:        ipAsset.Certificate,
+				Version:            version,
 			},
 		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, err)
+			return
+		}
+		ctx.Header("ETag", etag(res.IpAsset.GetVersion()))
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_ipassets": res.IpAsset,
+		})
+		return
+
+	})
+	r.PATCH("/table_ipassets/:registration_number", func(ctx *gin.Context) {
+		var ipAsset IP_Asset
+		mask, err := bindPatch(ctx, &ipAsset, "registration_number", "version")
+		if err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		dateOfCreation, dateRegistered, err := ipAsset.dates()
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		version, ok := ifMatch(ctx, ipAsset.Version)
+		if !ok {
+			return
+		}
+		res, err := client.UpdateIP_Asset(ctx, &pb.UpdateIP_AssetRequest{
+			IpAsset: &pb.IP_Asset{
+				RegistrationNumber: ctx.Param("registration_number"),
+				TitleOfWork:        ipAsset.TitleOfWork,
+				TypeOfDocument:     ipAsset.TypeOfDocument,
+				ClassOfWork:        ipAsset.ClassOfWork,
+				DateOfCreation:     dateOfCreation,
+				DateRegistered:     dateRegistered,
+				Campus:             ipAsset.Campus,
+				College:            ipAsset.College,
+				Program:            ipAsset.Program,
+				Authors:            ipAsset.Authors,
+				Hyperlink:          ipAsset.Hyperlink,
+				Status:             ipAsset.Status,
+				Certificate:        ipAsset.Certificate,
+				Version:            version,
+			},
+			UpdateMask: mask,
+		})
+		if err != nil {
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.IpAsset.GetVersion()))
 		ctx.JSON(http.StatusOK, gin.H{
 			"table_ipassets": res.IpAsset,
 		})
@@ -310,11 +886,13 @@ func main() {
 	})
 	r.DELETE("/table_ipassets/:registration_number", func(ctx *gin.Context) {
 		id := ctx.Param("registration_number")
-		res, err := client.DeleteIP_Asset(ctx, &pb.DeleteIP_AssetRequest{RegistrationNumber: id})
+		version, ok := ifMatch(ctx, 0)
+		if !ok {
+			return
+		}
+		res, err := client.DeleteIP_Asset(ctx, &pb.DeleteIP_AssetRequest{RegistrationNumber: id, Version: version})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
 		if res.Success == true {
@@ -323,9 +901,7 @@ func main() {
 			})
 			return
 		} else {
-			ctx.JSON(http.StatusInternalServerError, gin.H{
-				"error": "error deleting ip asset",
-			})
+			writeError(ctx, status.Error(codes.Internal, "error deleting ip asset"))
 			return
 		}
 
@@ -333,26 +909,36 @@ func main() {
 
 	//table_publications
 	r.GET("/table_publications", func(ctx *gin.Context) {
-		res, err := client.GetPublications(ctx, &pb.ReadPublicationsRequest{})
+		var query ListQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.GetPublications(ctx, &pb.ReadPublicationsRequest{
+			PageSize:         query.PageSize,
+			PageToken:        query.PageToken,
+			IncludeTotalSize: query.IncludeTotalSize,
+			Filter:           query.Filter,
+			OrderBy:          query.OrderBy,
+		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, err)
 			return
 		}
 		ctx.JSON(http.StatusOK, gin.H{
 			"table_publications": res.Publications,
+			"next_page_token":    res.NextPageToken,
+			"total_size":         res.TotalSize,
 		})
 	})
 	r.GET("/table_publications/:publication_id", func(ctx *gin.Context) {
 		id := ctx.Param("publication_id")
 		res, err := client.GetPublication(ctx, &pb.ReadPublicationRequest{PublicationId: id})
 		if err != nil {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"message": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.Publication.GetVersion()))
 		ctx.JSON(http.StatusOK, gin.H{
 			"table_publications": res.Publication,
 		})
@@ -362,14 +948,17 @@ func main() {
 
 		err := ctx.ShouldBind(&publication)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		datePublished, err := publication.dates()
+		if err != nil {
+			writeError(ctx, err)
 			return
 		}
 		data := &pb.Publication{
 			PublicationId:        publication.PublicationID,
-			DatePublished:        publication.DatePublished,
+			DatePublished:        datePublished,
 			Quartile:             publication.Quartile,
 			Authors:              publication.Authors,
 			Department:           publication.Department,
@@ -385,16 +974,16 @@ func main() {
 			NatureOfFunding:      publication.NatureOfFunding,
 			Publisher:            publication.Publisher,
 			Abstract:             publication.Abstract,
+			Draft:                publication.Draft,
 		}
 		res, err := client.CreatePublication(ctx, &pb.CreatePublicationRequest{
 			Publication: data,
 		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.Publication.GetVersion()))
 		ctx.JSON(http.StatusCreated, gin.H{
 			"table_publications": res.Publication,
 		})
@@ -403,16 +992,23 @@ func main() {
 		var publication Publication
 		err := ctx.ShouldBind(&publication)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		datePublished, err := publication.dates()
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		version, ok := ifMatch(ctx, publication.Version)
+		if !ok {
 			return
 		}
 		numberOfCitation, _ := strconv.Atoi(ctx.Param("number_of_citation"))
 		res, err := client.UpdatePublication(ctx, &pb.UpdatePublicationRequest{
 			Publication: &pb.Publication{
 				PublicationId:        publication.PublicationID,
-				DatePublished:        publication.DatePublished,
+				DatePublished:        datePublished,
 				Quartile:             publication.Quartile,
 				Authors:              publication.Authors,
 				Department:           publication.Department,
@@ -428,68 +1024,130 @@ func main() {
 				NatureOfFunding:      publication.NatureOfFunding,
 				Publisher:            publication.Publisher,
 				Abstract:             publication.Abstract,
+				Draft:                publication.Draft,
+				Version:              version,
 			},
 		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.Publication.GetVersion()))
 		ctx.JSON(http.StatusOK, gin.H{
 			"table_publications": res.Publication,
 		})
 		return
 
 	})
-	r.DELETE("/table_publications/:publication_id", func(ctx *gin.Context) {
-		id := ctx.Param("publication_id")
-		res, err := client.DeletePublication(ctx, &pb.DeletePublicationRequest{PublicationId: id})
+	r.PATCH("/table_publications/:publication_id", func(ctx *gin.Context) {
+		var publication Publication
+		mask, err := bindPatch(ctx, &publication, "publication_id", "version")
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
-			return
-		}
-		if res.Success == true {
-			ctx.JSON(http.StatusOK, gin.H{
-				"message": "Publication deleted successfully",
-			})
-			return
-		} else {
-			ctx.JSON(http.StatusInternalServerError, gin.H{
-				"error": "error deleting publication",
-			})
+			writeError(ctx, invalidRequest(err))
 			return
 		}
-
-	})
-
-	//table_user
-	r.GET("/table_user", func(ctx *gin.Context) {
-		res, err := client.GetUsers(ctx, &pb.ReadUsersRequest{})
+		datePublished, err := publication.dates()
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, err)
 			return
 		}
-		ctx.JSON(http.StatusOK, gin.H{
-			"table_user": res.Users,
-		})
-	})
-	r.GET("/table_user/:user_id", func(ctx *gin.Context) {
-		id := ctx.Param("user_id")
-		userID, _ := strconv.Atoi(id)
-		res, err := client.GetUser(ctx, &pb.ReadUserRequest{UserId: int32(userID)})
-		if err != nil {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"message": err.Error(),
-			})
+		version, ok := ifMatch(ctx, publication.Version)
+		if !ok {
 			return
 		}
-		ctx.JSON(http.StatusOK, gin.H{
-			"table_user": res.User,
+		res, err := client.UpdatePublication(ctx, &pb.UpdatePublicationRequest{
+			Publication: &pb.Publication{
+				PublicationId:        ctx.Param("publication_id"),
+				DatePublished:        datePublished,
+				Quartile:             publication.Quartile,
+				Authors:              publication.Authors,
+				Department:           publication.Department,
+				College:              publication.College,
+				Campus:               publication.Campus,
+				TitleOfPaper:         publication.TitleOfPaper,
+				TypeOfPublication:    publication.TypeOfPublication,
+				FundingSource:        publication.FundingSource,
+				NumberOfCitation:     int32(publication.NumberOfCitation),
+				GoogleScholarDetails: publication.GoogleScholarDetails,
+				SdgNo:                publication.SDGNo,
+				FundingType:          publication.FundingType,
+				NatureOfFunding:      publication.NatureOfFunding,
+				Publisher:            publication.Publisher,
+				Abstract:             publication.Abstract,
+				Draft:                publication.Draft,
+				Version:              version,
+			},
+			UpdateMask: mask,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.Header("ETag", etag(res.Publication.GetVersion()))
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_publications": res.Publication,
+		})
+		return
+
+	})
+	r.DELETE("/table_publications/:publication_id", func(ctx *gin.Context) {
+		id := ctx.Param("publication_id")
+		version, ok := ifMatch(ctx, 0)
+		if !ok {
+			return
+		}
+		res, err := client.DeletePublication(ctx, &pb.DeletePublicationRequest{PublicationId: id, Version: version})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		if res.Success == true {
+			ctx.JSON(http.StatusOK, gin.H{
+				"message": "Publication deleted successfully",
+			})
+			return
+		} else {
+			writeError(ctx, status.Error(codes.Internal, "error deleting publication"))
+			return
+		}
+
+	})
+
+	//table_user
+	r.GET("/table_user", func(ctx *gin.Context) {
+		var query ListQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.GetUsers(ctx, &pb.ReadUsersRequest{
+			PageSize:         query.PageSize,
+			PageToken:        query.PageToken,
+			IncludeTotalSize: query.IncludeTotalSize,
+			Filter:           query.Filter,
+			OrderBy:          query.OrderBy,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_user":      res.Users,
+			"next_page_token": res.NextPageToken,
+			"total_size":      res.TotalSize,
+		})
+	})
+	r.GET("/table_user/:user_id", func(ctx *gin.Context) {
+		id := ctx.Param("user_id")
+		userID, _ := strconv.Atoi(id)
+		res, err := client.GetUser(ctx, &pb.ReadUserRequest{UserId: int32(userID)})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.Header("ETag", etag(res.User.GetVersion()))
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_user": res.User,
 		})
 	})
 	r.POST("/table_user", func(ctx *gin.Context) {
@@ -497,9 +1155,7 @@ func main() {
 
 		err := ctx.ShouldBind(&user)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, invalidRequest(err))
 			return
 		}
 		data := &pb.User{
@@ -518,11 +1174,10 @@ func main() {
 			User: data,
 		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.User.GetVersion()))
 		ctx.JSON(http.StatusCreated, gin.H{
 			"table_user": res.User,
 		})
@@ -531,9 +1186,11 @@ func main() {
 		var user User
 		err := ctx.ShouldBind(&user)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		version, ok := ifMatch(ctx, user.Version)
+		if !ok {
 			return
 		}
 		userID, _ := strconv.Atoi(ctx.Param("user_id"))
@@ -549,14 +1206,53 @@ func main() {
 				UserFname:   user.UserFname,
 				UserLname:   user.UserLname,
 				UserMname:   user.UserMname,
+				Version:     version,
 			},
 		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
+		ctx.Header("ETag", etag(res.User.GetVersion()))
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_user": res.User,
+		})
+		return
+
+	})
+	r.PATCH("/table_user/:user_id", func(ctx *gin.Context) {
+		var user User
+		mask, err := bindPatch(ctx, &user, "user_id", "version")
+		if err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		version, ok := ifMatch(ctx, user.Version)
+		if !ok {
+			return
+		}
+		userID, _ := strconv.Atoi(ctx.Param("user_id"))
+		res, err := client.UpdateUser(ctx, &pb.UpdateUserRequest{
+			User: &pb.User{
+				UserId:      int32(userID),
+				SrCode:      user.SRCode,
+				Email:       user.Email,
+				Password:    user.Password,
+				AccountType: user.AccountType,
+				UserContact: user.UserContact,
+				UserImg:     user.UserImg,
+				UserFname:   user.UserFname,
+				UserLname:   user.UserLname,
+				UserMname:   user.UserMname,
+				Version:     version,
+			},
+			UpdateMask: mask,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.Header("ETag", etag(res.User.GetVersion()))
 		ctx.JSON(http.StatusOK, gin.H{
 			"table_user": res.User,
 		})
@@ -566,11 +1262,13 @@ func main() {
 	r.DELETE("/table_user/:user_id", func(ctx *gin.Context) {
 		id := ctx.Param("user_id")
 		userID, _ := strconv.Atoi(id)
-		res, err := client.DeleteUser(ctx, &pb.DeleteUserRequest{UserId: int32(userID)})
+		version, ok := ifMatch(ctx, 0)
+		if !ok {
+			return
+		}
+		res, err := client.DeleteUser(ctx, &pb.DeleteUserRequest{UserId: int32(userID), Version: version})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
 		if res.Success == true {
@@ -579,9 +1277,7 @@ func main() {
 			})
 			return
 		} else {
-			ctx.JSON(http.StatusInternalServerError, gin.H{
-				"error": "error deleting user",
-			})
+			writeError(ctx, status.Error(codes.Internal, "error deleting user"))
 			return
 		}
 
@@ -589,24 +1285,33 @@ func main() {
 
 	//table_log
 	r.GET("/table_log", func(ctx *gin.Context) {
-		res, err := client.GetLogs(ctx, &pb.ReadLogsRequest{})
+		var query ListQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.GetLogs(ctx, &pb.ReadLogsRequest{
+			PageSize:         query.PageSize,
+			PageToken:        query.PageToken,
+			IncludeTotalSize: query.IncludeTotalSize,
+			Filter:           query.Filter,
+			OrderBy:          query.OrderBy,
+		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, err)
 			return
 		}
 		ctx.JSON(http.StatusOK, gin.H{
-			"table_log": res.Logs,
+			"table_log":       res.Logs,
+			"next_page_token": res.NextPageToken,
+			"total_size":      res.TotalSize,
 		})
 	})
 	r.GET("/table_log/:log_id", func(ctx *gin.Context) {
 		id := ctx.Param("log_id")
 		res, err := client.GetLog(ctx, &pb.ReadLogRequest{LogId: id})
 		if err != nil {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"message": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
 		ctx.JSON(http.StatusOK, gin.H{
@@ -618,82 +1323,747 @@ func main() {
 
 		err := ctx.ShouldBind(&log)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, invalidRequest(err))
 			return
 		}
 		data := &pb.Log{
-			LogId:       log.LogID,
-			DateTime:    log.DateTime,
-			UserId:      int32(log.UserID),
 			Activity:    log.Activity,
 			Description: log.Description,
+			Entity:      log.Entity,
+			EntityId:    log.EntityID,
 		}
 		res, err := client.CreateLog(ctx, &pb.CreateLogRequest{
 			Log: data,
 		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
-			})
+			writeError(ctx, err)
 			return
 		}
 		ctx.JSON(http.StatusCreated, gin.H{
 			"table_log": res.Log,
 		})
 	})
-	r.PUT("/table_log/:log_id", func(ctx *gin.Context) {
-		var log Log
-		err := ctx.ShouldBind(&log)
+
+	//log chain
+	r.POST("/log_chain/verify", func(ctx *gin.Context) {
+		var req pb.VerifyLogChainRequest
+		if ctx.Request.ContentLength != 0 {
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				writeError(ctx, invalidRequest(err))
+				return
+			}
+		}
+		res, err := client.VerifyLogChain(ctx, &req)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
-		res, err := client.UpdateLog(ctx, &pb.UpdateLogRequest{
-			Log: &pb.Log{
-				LogId:       log.LogID,
-				DateTime:    log.DateTime,
-				UserId:      int32(log.UserID),
-				Activity:    log.Activity,
-				Description: log.Description,
-			},
+		ctx.JSON(http.StatusOK, res)
+	})
+	r.GET("/log_chain/checkpoint", func(ctx *gin.Context) {
+		res, err := client.ExportLogCheckpoint(ctx, &pb.ExportLogCheckpointRequest{})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, res.Checkpoint)
+	})
+
+	//authenticate
+	r.POST("/authenticate", func(ctx *gin.Context) {
+		var login Login
+		if err := ctx.ShouldBind(&login); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.Authenticate(ctx, &pb.AuthenticateRequest{
+			Login:    login.Login,
+			Password: login.Password,
 		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, err)
 			return
 		}
 		ctx.JSON(http.StatusOK, gin.H{
-			"table_log": res.Log,
+			"access_token": res.AccessToken,
+			"token_type":   res.TokenType,
+			"expires_in":   res.ExpiresIn,
+			"user":         res.User,
 		})
-		return
+	})
 
+	//author links
+	r.GET("/table_authors/:author_id/publications", func(ctx *gin.Context) {
+		id := ctx.Param("author_id")
+		res, err := client.ListAuthorPublications(ctx, &pb.ListAuthorPublicationsRequest{AuthorId: id})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_publications": res.Publications,
+		})
 	})
-	r.DELETE("/table_log/:log_id", func(ctx *gin.Context) {
-		id := ctx.Param("log_id")
-		res, err := client.DeleteLog(ctx, &pb.DeleteLogRequest{LogId: id})
+	r.GET("/table_authors/:author_id/ipassets", func(ctx *gin.Context) {
+		id := ctx.Param("author_id")
+		res, err := client.ListAuthorIP_Assets(ctx, &pb.ListAuthorIP_AssetsRequest{AuthorId: id})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_ipassets": res.IpAssets,
+		})
+	})
+	r.POST("/table_publications/:publication_id/authors", func(ctx *gin.Context) {
+		var link AuthorLink
+		if err := ctx.ShouldBind(&link); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.LinkPublicationAuthor(ctx, &pb.LinkPublicationAuthorRequest{
+			PublicationId: ctx.Param("publication_id"),
+			AuthorId:      link.AuthorID,
+			AuthorOrder:   link.AuthorOrder,
+			Role:          link.Role,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_publications": res.Publication,
+		})
+	})
+	r.DELETE("/table_publications/:publication_id/authors/:author_id", func(ctx *gin.Context) {
+		res, err := client.UnlinkPublicationAuthor(ctx, &pb.UnlinkPublicationAuthorRequest{
+			PublicationId: ctx.Param("publication_id"),
+			AuthorId:      ctx.Param("author_id"),
+		})
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
+			writeError(ctx, err)
+			return
+		}
+		if res.Success {
+			ctx.JSON(http.StatusOK, gin.H{
+				"message": "Author unlinked successfully",
 			})
 			return
 		}
-		if res.Success == true {
+		writeError(ctx, status.Error(codes.Internal, "error unlinking author"))
+	})
+	r.POST("/table_ipassets/:registration_number/authors", func(ctx *gin.Context) {
+		var link AuthorLink
+		if err := ctx.ShouldBind(&link); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.LinkIP_AssetAuthor(ctx, &pb.LinkIP_AssetAuthorRequest{
+			RegistrationNumber: ctx.Param("registration_number"),
+			AuthorId:           link.AuthorID,
+			AuthorOrder:        link.AuthorOrder,
+			Role:               link.Role,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_ipassets": res.IpAsset,
+		})
+	})
+	r.DELETE("/table_ipassets/:registration_number/authors/:author_id", func(ctx *gin.Context) {
+		res, err := client.UnlinkIP_AssetAuthor(ctx, &pb.UnlinkIP_AssetAuthorRequest{
+			RegistrationNumber: ctx.Param("registration_number"),
+			AuthorId:           ctx.Param("author_id"),
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		if res.Success {
 			ctx.JSON(http.StatusOK, gin.H{
-				"message": "Log deleted successfully",
+				"message": "Author unlinked successfully",
 			})
 			return
+		}
+		writeError(ctx, status.Error(codes.Internal, "error unlinking author"))
+	})
+
+	//trash
+	r.GET("/table_authors/deleted", func(ctx *gin.Context) {
+		var query ListQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.ListDeletedAuthors(ctx, &pb.ReadAuthorsRequest{
+			PageSize:         query.PageSize,
+			PageToken:        query.PageToken,
+			IncludeTotalSize: query.IncludeTotalSize,
+			Filter:           query.Filter,
+			OrderBy:          query.OrderBy,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_authors":   res.Authors,
+			"next_page_token": res.NextPageToken,
+			"total_size":      res.TotalSize,
+		})
+	})
+	r.POST("/table_authors/:author_id/restore", func(ctx *gin.Context) {
+		res, err := client.RestoreAuthor(ctx, &pb.RestoreAuthorRequest{AuthorId: ctx.Param("author_id")})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_authors": res.Author,
+		})
+	})
+	r.GET("/table_ipassets/deleted", func(ctx *gin.Context) {
+		var query ListQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.ListDeletedIP_Assets(ctx, &pb.ReadIP_AssetsRequest{
+			PageSize:         query.PageSize,
+			PageToken:        query.PageToken,
+			IncludeTotalSize: query.IncludeTotalSize,
+			Filter:           query.Filter,
+			OrderBy:          query.OrderBy,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_ipassets":  res.IpAssets,
+			"next_page_token": res.NextPageToken,
+			"total_size":      res.TotalSize,
+		})
+	})
+	r.POST("/table_ipassets/:registration_number/restore", func(ctx *gin.Context) {
+		res, err := client.RestoreIP_Asset(ctx, &pb.RestoreIP_AssetRequest{RegistrationNumber: ctx.Param("registration_number")})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_ipassets": res.IpAsset,
+		})
+	})
+	r.GET("/table_publications/deleted", func(ctx *gin.Context) {
+		var query ListQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.ListDeletedPublications(ctx, &pb.ReadPublicationsRequest{
+			PageSize:         query.PageSize,
+			PageToken:        query.PageToken,
+			IncludeTotalSize: query.IncludeTotalSize,
+			Filter:           query.Filter,
+			OrderBy:          query.OrderBy,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_publications": res.Publications,
+			"next_page_token":    res.NextPageToken,
+			"total_size":         res.TotalSize,
+		})
+	})
+	r.POST("/table_publications/:publication_id/restore", func(ctx *gin.Context) {
+		res, err := client.RestorePublication(ctx, &pb.RestorePublicationRequest{PublicationId: ctx.Param("publication_id")})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"table_publications": res.Publication,
+		})
+	})
+
+	//batches
+	r.POST("/table_authors:method", func(ctx *gin.Context) {
+		if !isCustomMethod(ctx, "batchCreate", "batchUpdate") {
+			return
+		}
+		var body BatchBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		mode, err := batchMode(body.Mode)
+		if err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		var v violations
+		results := []gin.H{}
+		if ctx.Param("method") == ":batchCreate" {
+			req := &pb.BatchCreateAuthorsRequest{Mode: mode}
+			for i, item := range body.Requests {
+				var author Author
+				if err := json.Unmarshal(item, &author); err != nil {
+					writeError(ctx, invalidRequest(fmt.Errorf("requests[%d]: %w", i, err)))
+					return
+				}
+				req.Requests = append(req.Requests, &pb.CreateAuthorRequest{Author: author.proto()})
+			}
+			if err := v.err(); err != nil {
+				writeError(ctx, err)
+				return
+			}
+			res, err := client.BatchCreateAuthors(ctx, req)
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			for _, result := range res.Results {
+				results = append(results, batchResult("table_authors", result.Author, result.Status))
+			}
+		} else {
+			req := &pb.BatchUpdateAuthorsRequest{Mode: mode}
+			for i, item := range body.Requests {
+				var author Author
+				mask, err := patchMask(item, &author, "author_id", "version")
+				if err != nil {
+					writeError(ctx, invalidRequest(fmt.Errorf("requests[%d]: %w", i, err)))
+					return
+				}
+				req.Requests = append(req.Requests, &pb.UpdateAuthorRequest{Author: author.proto(), UpdateMask: mask})
+			}
+			if err := v.err(); err != nil {
+				writeError(ctx, err)
+				return
+			}
+			res, err := client.BatchUpdateAuthors(ctx, req)
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			for _, result := range res.Results {
+				results = append(results, batchResult("table_authors", result.Author, result.Status))
+			}
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"results": results,
+		})
+	})
+	r.POST("/table_ipassets:method", func(ctx *gin.Context) {
+		if !isCustomMethod(ctx, "batchCreate", "batchUpdate", "import") {
+			return
+		}
+		if ctx.Param("method") == ":import" {
+			importUpload(ctx, client, "ip_asset")
+			return
+		}
+		var body BatchBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		mode, err := batchMode(body.Mode)
+		if err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		var v violations
+		results := []gin.H{}
+		if ctx.Param("method") == ":batchCreate" {
+			req := &pb.BatchCreateIP_AssetsRequest{Mode: mode}
+			for i, item := range body.Requests {
+				var ipAsset IP_Asset
+				if err := json.Unmarshal(item, &ipAsset); err != nil {
+					writeError(ctx, invalidRequest(fmt.Errorf("requests[%d]: %w", i, err)))
+					return
+				}
+				req.Requests = append(req.Requests, &pb.CreateIP_AssetRequest{IpAsset: ipAsset.proto(&v, fmt.Sprintf("requests[%d].", i))})
+			}
+			if err := v.err(); err != nil {
+				writeError(ctx, err)
+				return
+			}
+			res, err := client.BatchCreateIP_Assets(ctx, req)
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			for _, result := range res.Results {
+				results = append(results, batchResult("table_ipassets", result.IpAsset, result.Status))
+			}
+		} else {
+			req := &pb.BatchUpdateIP_AssetsRequest{Mode: mode}
+			for i, item := range body.Requests {
+				var ipAsset IP_Asset
+				mask, err := patchMask(item, &ipAsset, "registration_number", "version")
+				if err != nil {
+					writeError(ctx, invalidRequest(fmt.Errorf("requests[%d]: %w", i, err)))
+					return
+				}
+				req.Requests = append(req.Requests, &pb.UpdateIP_AssetRequest{IpAsset: ipAsset.proto(&v, fmt.Sprintf("requests[%d].", i)), UpdateMask: mask})
+			}
+			if err := v.err(); err != nil {
+				writeError(ctx, err)
+				return
+			}
+			res, err := client.BatchUpdateIP_Assets(ctx, req)
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			for _, result := range res.Results {
+				results = append(results, batchResult("table_ipassets", result.IpAsset, result.Status))
+			}
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"results": results,
+		})
+	})
+	r.POST("/table_publications:method", func(ctx *gin.Context) {
+		if !isCustomMethod(ctx, "batchCreate", "batchUpdate", "import", "importCitations") {
+			return
+		}
+		if ctx.Param("method") == ":import" {
+			importUpload(ctx, client, "publication")
+			return
+		}
+		if ctx.Param("method") == ":importCitations" {
+			citationsUpload(ctx, client)
+			return
+		}
+		var body BatchBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		mode, err := batchMode(body.Mode)
+		if err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		var v violations
+		results := []gin.H{}
+		if ctx.Param("method") == ":batchCreate" {
+			req := &pb.BatchCreatePublicationsRequest{Mode: mode}
+			for i, item := range body.Requests {
+				var publication Publication
+				if err := json.Unmarshal(item, &publication); err != nil {
+					writeError(ctx, invalidRequest(fmt.Errorf("requests[%d]: %w", i, err)))
+					return
+				}
+				req.Requests = append(req.Requests, &pb.CreatePublicationRequest{Publication: publication.proto(&v, fmt.Sprintf("requests[%d].", i))})
+			}
+			if err := v.err(); err != nil {
+				writeError(ctx, err)
+				return
+			}
+			res, err := client.BatchCreatePublications(ctx, req)
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			for _, result := range res.Results {
+				results = append(results, batchResult("table_publications", result.Publication, result.Status))
+			}
+		} else {
+			req := &pb.BatchUpdatePublicationsRequest{Mode: mode}
+			for i, item := range body.Requests {
+				var publication Publication
+				mask, err := patchMask(item, &publication, "publication_id", "version")
+				if err != nil {
+					writeError(ctx, invalidRequest(fmt.Errorf("requests[%d]: %w", i, err)))
+					return
+				}
+				req.Requests = append(req.Requests, &pb.UpdatePublicationRequest{Publication: publication.proto(&v, fmt.Sprintf("requests[%d].", i)), UpdateMask: mask})
+			}
+			if err := v.err(); err != nil {
+				writeError(ctx, err)
+				return
+			}
+			res, err := client.BatchUpdatePublications(ctx, req)
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			for _, result := range res.Results {
+				results = append(results, batchResult("table_publications", result.Publication, result.Status))
+			}
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"results": results,
+		})
+	})
+	r.POST("/table_user:method", func(ctx *gin.Context) {
+		if !isCustomMethod(ctx, "batchCreate", "batchUpdate") {
+			return
+		}
+		var body BatchBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		mode, err := batchMode(body.Mode)
+		if err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		var v violations
+		results := []gin.H{}
+		if ctx.Param("method") == ":batchCreate" {
+			req := &pb.BatchCreateUsersRequest{Mode: mode}
+			for i, item := range body.Requests {
+				var user User
+				if err := json.Unmarshal(item, &user); err != nil {
+					writeError(ctx, invalidRequest(fmt.Errorf("requests[%d]: %w", i, err)))
+					return
+				}
+				req.Requests = append(req.Requests, &pb.CreateUserRequest{User: user.proto()})
+			}
+			if err := v.err(); err != nil {
+				writeError(ctx, err)
+				return
+			}
+			res, err := client.BatchCreateUsers(ctx, req)
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			for _, result := range res.Results {
+				results = append(results, batchResult("table_user", result.User, result.Status))
+			}
 		} else {
-			ctx.JSON(http.StatusInternalServerError, gin.H{
-				"error": "error deleting log",
+			req := &pb.BatchUpdateUsersRequest{Mode: mode}
+			for i, item := range body.Requests {
+				var user User
+				mask, err := patchMask(item, &user, "user_id", "version")
+				if err != nil {
+					writeError(ctx, invalidRequest(fmt.Errorf("requests[%d]: %w", i, err)))
+					return
+				}
+				req.Requests = append(req.Requests, &pb.UpdateUserRequest{User: user.proto(), UpdateMask: mask})
+			}
+			if err := v.err(); err != nil {
+				writeError(ctx, err)
+				return
+			}
+			res, err := client.BatchUpdateUsers(ctx, req)
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			for _, result := range res.Results {
+				results = append(results, batchResult("table_user", result.User, result.Status))
+			}
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"results": results,
+		})
+	})
+
+	//revisions
+	revisionTables := []struct{ table, param, entity string }{
+		{"table_authors", "author_id", "author"},
+		{"table_ipassets", "registration_number", "ip_asset"},
+		{"table_publications", "publication_id", "publication"},
+	}
+	for _, t := range revisionTables {
+		t := t
+		r.GET("/"+t.table+"/:"+t.param+"/revisions", func(ctx *gin.Context) {
+			res, err := client.ListRevisions(ctx, &pb.ListRevisionsRequest{
+				Entity:   t.entity,
+				EntityId: ctx.Param(t.param),
+			})
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			ctx.JSON(http.StatusOK, gin.H{
+				"revisions": res.Revisions,
 			})
+		})
+		r.GET("/"+t.table+"/:"+t.param+"/revisions/diff", func(ctx *gin.Context) {
+			var query DiffQuery
+			if err := ctx.ShouldBindQuery(&query); err != nil {
+				writeError(ctx, invalidRequest(err))
+				return
+			}
+			res, err := client.DiffRevisions(ctx, &pb.DiffRevisionsRequest{
+				Entity:       t.entity,
+				EntityId:     ctx.Param(t.param),
+				FromRevision: query.From,
+				ToRevision:   query.To,
+			})
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			ctx.JSON(http.StatusOK, gin.H{
+				"changes": res.Changes,
+			})
+		})
+		r.POST("/"+t.table+"/:"+t.param+"/revisions/:revision/revert", func(ctx *gin.Context) {
+			revision, err := strconv.ParseInt(ctx.Param("revision"), 10, 32)
+			if err != nil {
+				writeError(ctx, status.Error(codes.InvalidArgument, "revision must be a number"))
+				return
+			}
+			res, err := client.RevertToRevision(ctx, &pb.RevertToRevisionRequest{
+				Entity:   t.entity,
+				EntityId: ctx.Param(t.param),
+				Revision: int32(revision),
+			})
+			if err != nil {
+				writeError(ctx, err)
+				return
+			}
+			ctx.JSON(http.StatusOK, gin.H{
+				"revision": res.Revision,
+			})
+		})
+	}
+
+	//exports
+	exportTables := []struct{ table, entity string }{
+		{"table_authors", "author"},
+		{"table_ipassets", "ip_asset"},
+		{"table_publications", "publication"},
+		{"table_user", "user"},
+		{"table_log", "log"},
+	}
+	for _, t := range exportTables {
+		t := t
+		r.GET("/"+t.table+"/export", func(ctx *gin.Context) {
+			exportDownload(ctx, client, t.table, t.entity)
+		})
+	}
+
+	r.GET("/table_publications/citations", func(ctx *gin.Context) {
+		citationsDownload(ctx, client)
+	})
+
+	//vocabularies
+	r.GET("/vocabularies", func(ctx *gin.Context) {
+		res, err := client.ListVocabulary(ctx, &pb.ListVocabularyRequest{})
+		if err != nil {
+			writeError(ctx, err)
 			return
 		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"terms": res.Terms,
+		})
+	})
+	r.GET("/vocabularies/:vocabulary", func(ctx *gin.Context) {
+		res, err := client.ListVocabulary(ctx, &pb.ListVocabularyRequest{Vocabulary: ctx.Param("vocabulary")})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"terms": res.Terms,
+		})
+	})
+	r.POST("/vocabularies/:vocabulary", func(ctx *gin.Context) {
+		var term VocabularyTerm
+		if err := ctx.ShouldBind(&term); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.CreateVocabularyTerm(ctx, &pb.CreateVocabularyTermRequest{
+			Term: &pb.VocabularyTerm{
+				Vocabulary: ctx.Param("vocabulary"),
+				Value:      term.Value,
+				Label:      term.Label,
+				Position:   term.Position,
+			},
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusCreated, gin.H{
+			"term": res.Term,
+		})
+	})
+	r.PATCH("/vocabularies/:vocabulary/:value", func(ctx *gin.Context) {
+		var term VocabularyTerm
+		mask, err := bindPatch(ctx, &term, "value")
+		if err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.UpdateVocabularyTerm(ctx, &pb.UpdateVocabularyTermRequest{
+			Term: &pb.VocabularyTerm{
+				Vocabulary: ctx.Param("vocabulary"),
+				Value:      ctx.Param("value"),
+				Label:      term.Label,
+				Position:   term.Position,
+			},
+			UpdateMask: mask,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"term": res.Term,
+		})
+	})
+	r.DELETE("/vocabularies/:vocabulary/:value", func(ctx *gin.Context) {
+		res, err := client.DeleteVocabularyTerm(ctx, &pb.DeleteVocabularyTermRequest{
+			Vocabulary: ctx.Param("vocabulary"),
+			Value:      ctx.Param("value"),
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"success": res.Success,
+		})
+	})
+	r.GET("/vocabulary_violations", func(ctx *gin.Context) {
+		var query VocabularyQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.ListVocabularyViolations(ctx, &pb.ListVocabularyViolationsRequest{Vocabulary: query.Vocabulary})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"violations": res.Violations,
+		})
+	})
 
+	//search
+	r.GET("/search", func(ctx *gin.Context) {
+		var query SearchQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			writeError(ctx, invalidRequest(err))
+			return
+		}
+		res, err := client.Search(ctx, &pb.SearchRequest{
+			Query:    query.Q,
+			PageSize: query.PageSize,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"results": res.Results,
+		})
 	})
 
 	r.Run(":5000")
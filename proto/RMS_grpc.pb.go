@@ -27,16 +27,22 @@ type RMSServiceClient interface {
 	GetAuthors(ctx context.Context, in *ReadAuthorsRequest, opts ...grpc.CallOption) (*ReadAuthorsResponse, error)
 	UpdateAuthor(ctx context.Context, in *UpdateAuthorRequest, opts ...grpc.CallOption) (*UpdateAuthorResponse, error)
 	DeleteAuthor(ctx context.Context, in *DeleteAuthorRequest, opts ...grpc.CallOption) (*DeleteAuthorResponse, error)
+	ListDeletedAuthors(ctx context.Context, in *ReadAuthorsRequest, opts ...grpc.CallOption) (*ReadAuthorsResponse, error)
+	RestoreAuthor(ctx context.Context, in *RestoreAuthorRequest, opts ...grpc.CallOption) (*RestoreAuthorResponse, error)
 	CreateIP_Asset(ctx context.Context, in *CreateIP_AssetRequest, opts ...grpc.CallOption) (*CreateIP_AssetResponse, error)
 	GetIP_Asset(ctx context.Context, in *ReadIP_AssetRequest, opts ...grpc.CallOption) (*ReadIP_AssetResponse, error)
 	GetIP_Assets(ctx context.Context, in *ReadIP_AssetsRequest, opts ...grpc.CallOption) (*ReadIP_AssetsResponse, error)
 	UpdateIP_Asset(ctx context.Context, in *UpdateIP_AssetRequest, opts ...grpc.CallOption) (*UpdateIP_AssetResponse, error)
 	DeleteIP_Asset(ctx context.Context, in *DeleteIP_AssetRequest, opts ...grpc.CallOption) (*DeleteIP_AssetResponse, error)
+	ListDeletedIP_Assets(ctx context.Context, in *ReadIP_AssetsRequest, opts ...grpc.CallOption) (*ReadIP_AssetsResponse, error)
+	RestoreIP_Asset(ctx context.Context, in *RestoreIP_AssetRequest, opts ...grpc.CallOption) (*RestoreIP_AssetResponse, error)
 	CreatePublication(ctx context.Context, in *CreatePublicationRequest, opts ...grpc.CallOption) (*CreatePublicationResponse, error)
 	GetPublication(ctx context.Context, in *ReadPublicationRequest, opts ...grpc.CallOption) (*ReadPublicationResponse, error)
 	GetPublications(ctx context.Context, in *ReadPublicationsRequest, opts ...grpc.CallOption) (*ReadPublicationsResponse, error)
 	UpdatePublication(ctx context.Context, in *UpdatePublicationRequest, opts ...grpc.CallOption) (*UpdatePublicationResponse, error)
 	DeletePublication(ctx context.Context, in *DeletePublicationRequest, opts ...grpc.CallOption) (*DeletePublicationResponse, error)
+	ListDeletedPublications(ctx context.Context, in *ReadPublicationsRequest, opts ...grpc.CallOption) (*ReadPublicationsResponse, error)
+	RestorePublication(ctx context.Context, in *RestorePublicationRequest, opts ...grpc.CallOption) (*RestorePublicationResponse, error)
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	GetUser(ctx context.Context, in *ReadUserRequest, opts ...grpc.CallOption) (*ReadUserResponse, error)
 	GetUsers(ctx context.Context, in *ReadUsersRequest, opts ...grpc.CallOption) (*ReadUsersResponse, error)
@@ -47,6 +53,36 @@ type RMSServiceClient interface {
 	GetLogs(ctx context.Context, in *ReadLogsRequest, opts ...grpc.CallOption) (*ReadLogsResponse, error)
 	UpdateLog(ctx context.Context, in *UpdateLogRequest, opts ...grpc.CallOption) (*UpdateLogResponse, error)
 	DeleteLog(ctx context.Context, in *DeleteLogRequest, opts ...grpc.CallOption) (*DeleteLogResponse, error)
+	LinkPublicationAuthor(ctx context.Context, in *LinkPublicationAuthorRequest, opts ...grpc.CallOption) (*LinkPublicationAuthorResponse, error)
+	UnlinkPublicationAuthor(ctx context.Context, in *UnlinkPublicationAuthorRequest, opts ...grpc.CallOption) (*UnlinkPublicationAuthorResponse, error)
+	LinkIP_AssetAuthor(ctx context.Context, in *LinkIP_AssetAuthorRequest, opts ...grpc.CallOption) (*LinkIP_AssetAuthorResponse, error)
+	UnlinkIP_AssetAuthor(ctx context.Context, in *UnlinkIP_AssetAuthorRequest, opts ...grpc.CallOption) (*UnlinkIP_AssetAuthorResponse, error)
+	ListAuthorPublications(ctx context.Context, in *ListAuthorPublicationsRequest, opts ...grpc.CallOption) (*ListAuthorPublicationsResponse, error)
+	ListAuthorIP_Assets(ctx context.Context, in *ListAuthorIP_AssetsRequest, opts ...grpc.CallOption) (*ListAuthorIP_AssetsResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	VerifyLogChain(ctx context.Context, in *VerifyLogChainRequest, opts ...grpc.CallOption) (*VerifyLogChainResponse, error)
+	ExportLogCheckpoint(ctx context.Context, in *ExportLogCheckpointRequest, opts ...grpc.CallOption) (*ExportLogCheckpointResponse, error)
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error)
+	RevertToRevision(ctx context.Context, in *RevertToRevisionRequest, opts ...grpc.CallOption) (*RevertToRevisionResponse, error)
+	ListVocabulary(ctx context.Context, in *ListVocabularyRequest, opts ...grpc.CallOption) (*ListVocabularyResponse, error)
+	CreateVocabularyTerm(ctx context.Context, in *CreateVocabularyTermRequest, opts ...grpc.CallOption) (*CreateVocabularyTermResponse, error)
+	UpdateVocabularyTerm(ctx context.Context, in *UpdateVocabularyTermRequest, opts ...grpc.CallOption) (*UpdateVocabularyTermResponse, error)
+	DeleteVocabularyTerm(ctx context.Context, in *DeleteVocabularyTermRequest, opts ...grpc.CallOption) (*DeleteVocabularyTermResponse, error)
+	ListVocabularyViolations(ctx context.Context, in *ListVocabularyViolationsRequest, opts ...grpc.CallOption) (*ListVocabularyViolationsResponse, error)
+	BatchCreateAuthors(ctx context.Context, in *BatchCreateAuthorsRequest, opts ...grpc.CallOption) (*BatchCreateAuthorsResponse, error)
+	BatchUpdateAuthors(ctx context.Context, in *BatchUpdateAuthorsRequest, opts ...grpc.CallOption) (*BatchUpdateAuthorsResponse, error)
+	BatchCreateIP_Assets(ctx context.Context, in *BatchCreateIP_AssetsRequest, opts ...grpc.CallOption) (*BatchCreateIP_AssetsResponse, error)
+	BatchUpdateIP_Assets(ctx context.Context, in *BatchUpdateIP_AssetsRequest, opts ...grpc.CallOption) (*BatchUpdateIP_AssetsResponse, error)
+	BatchCreatePublications(ctx context.Context, in *BatchCreatePublicationsRequest, opts ...grpc.CallOption) (*BatchCreatePublicationsResponse, error)
+	BatchUpdatePublications(ctx context.Context, in *BatchUpdatePublicationsRequest, opts ...grpc.CallOption) (*BatchUpdatePublicationsResponse, error)
+	BatchCreateUsers(ctx context.Context, in *BatchCreateUsersRequest, opts ...grpc.CallOption) (*BatchCreateUsersResponse, error)
+	BatchUpdateUsers(ctx context.Context, in *BatchUpdateUsersRequest, opts ...grpc.CallOption) (*BatchUpdateUsersResponse, error)
+	ImportRecords(ctx context.Context, in *ImportRecordsRequest, opts ...grpc.CallOption) (*ImportRecordsResponse, error)
+	ExportRecords(ctx context.Context, in *ExportRecordsRequest, opts ...grpc.CallOption) (RMSService_ExportRecordsClient, error)
+	ExportCitations(ctx context.Context, in *ExportCitationsRequest, opts ...grpc.CallOption) (*ExportCitationsResponse, error)
+	ImportCitations(ctx context.Context, in *ImportCitationsRequest, opts ...grpc.CallOption) (*ImportCitationsResponse, error)
 }
 
 type rMSServiceClient struct {
@@ -102,6 +138,24 @@ func (c *rMSServiceClient) DeleteAuthor(ctx context.Context, in *DeleteAuthorReq
 	return out, nil
 }
 
+func (c *rMSServiceClient) ListDeletedAuthors(ctx context.Context, in *ReadAuthorsRequest, opts ...grpc.CallOption) (*ReadAuthorsResponse, error) {
+	out := new(ReadAuthorsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ListDeletedAuthors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) RestoreAuthor(ctx context.Context, in *RestoreAuthorRequest, opts ...grpc.CallOption) (*RestoreAuthorResponse, error) {
+	out := new(RestoreAuthorResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/RestoreAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rMSServiceClient) CreateIP_Asset(ctx context.Context, in *CreateIP_AssetRequest, opts ...grpc.CallOption) (*CreateIP_AssetResponse, error) {
 	out := new(CreateIP_AssetResponse)
 	err := c.cc.Invoke(ctx, "/proto.RMSService/CreateIP_Asset", in, out, opts...)
@@ -147,6 +201,24 @@ func (c *rMSServiceClient) DeleteIP_Asset(ctx context.Context, in *DeleteIP_Asse
 	return out, nil
 }
 
+func (c *rMSServiceClient) ListDeletedIP_Assets(ctx context.Context, in *ReadIP_AssetsRequest, opts ...grpc.CallOption) (*ReadIP_AssetsResponse, error) {
+	out := new(ReadIP_AssetsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ListDeletedIP_Assets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) RestoreIP_Asset(ctx context.Context, in *RestoreIP_AssetRequest, opts ...grpc.CallOption) (*RestoreIP_AssetResponse, error) {
+	out := new(RestoreIP_AssetResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/RestoreIP_Asset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rMSServiceClient) CreatePublication(ctx context.Context, in *CreatePublicationRequest, opts ...grpc.CallOption) (*CreatePublicationResponse, error) {
 	out := new(CreatePublicationResponse)
 	err := c.cc.Invoke(ctx, "/proto.RMSService/CreatePublication", in, out, opts...)
@@ -192,6 +264,24 @@ func (c *rMSServiceClient) DeletePublication(ctx context.Context, in *DeletePubl
 	return out, nil
 }
 
+func (c *rMSServiceClient) ListDeletedPublications(ctx context.Context, in *ReadPublicationsRequest, opts ...grpc.CallOption) (*ReadPublicationsResponse, error) {
+	out := new(ReadPublicationsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ListDeletedPublications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) RestorePublication(ctx context.Context, in *RestorePublicationRequest, opts ...grpc.CallOption) (*RestorePublicationResponse, error) {
+	out := new(RestorePublicationResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/RestorePublication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rMSServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
 	out := new(CreateUserResponse)
 	err := c.cc.Invoke(ctx, "/proto.RMSService/CreateUser", in, out, opts...)
@@ -282,6 +372,299 @@ func (c *rMSServiceClient) DeleteLog(ctx context.Context, in *DeleteLogRequest,
 	return out, nil
 }
 
+func (c *rMSServiceClient) LinkPublicationAuthor(ctx context.Context, in *LinkPublicationAuthorRequest, opts ...grpc.CallOption) (*LinkPublicationAuthorResponse, error) {
+	out := new(LinkPublicationAuthorResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/LinkPublicationAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) UnlinkPublicationAuthor(ctx context.Context, in *UnlinkPublicationAuthorRequest, opts ...grpc.CallOption) (*UnlinkPublicationAuthorResponse, error) {
+	out := new(UnlinkPublicationAuthorResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/UnlinkPublicationAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) LinkIP_AssetAuthor(ctx context.Context, in *LinkIP_AssetAuthorRequest, opts ...grpc.CallOption) (*LinkIP_AssetAuthorResponse, error) {
+	out := new(LinkIP_AssetAuthorResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/LinkIP_AssetAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) UnlinkIP_AssetAuthor(ctx context.Context, in *UnlinkIP_AssetAuthorRequest, opts ...grpc.CallOption) (*UnlinkIP_AssetAuthorResponse, error) {
+	out := new(UnlinkIP_AssetAuthorResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/UnlinkIP_AssetAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) ListAuthorPublications(ctx context.Context, in *ListAuthorPublicationsRequest, opts ...grpc.CallOption) (*ListAuthorPublicationsResponse, error) {
+	out := new(ListAuthorPublicationsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ListAuthorPublications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) ListAuthorIP_Assets(ctx context.Context, in *ListAuthorIP_AssetsRequest, opts ...grpc.CallOption) (*ListAuthorIP_AssetsResponse, error) {
+	out := new(ListAuthorIP_AssetsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ListAuthorIP_Assets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
+	out := new(AuthenticateResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/Authenticate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) VerifyLogChain(ctx context.Context, in *VerifyLogChainRequest, opts ...grpc.CallOption) (*VerifyLogChainResponse, error) {
+	out := new(VerifyLogChainResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/VerifyLogChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) ExportLogCheckpoint(ctx context.Context, in *ExportLogCheckpointRequest, opts ...grpc.CallOption) (*ExportLogCheckpointResponse, error) {
+	out := new(ExportLogCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ExportLogCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error) {
+	out := new(DiffRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/DiffRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) RevertToRevision(ctx context.Context, in *RevertToRevisionRequest, opts ...grpc.CallOption) (*RevertToRevisionResponse, error) {
+	out := new(RevertToRevisionResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/RevertToRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) ListVocabulary(ctx context.Context, in *ListVocabularyRequest, opts ...grpc.CallOption) (*ListVocabularyResponse, error) {
+	out := new(ListVocabularyResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ListVocabulary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) CreateVocabularyTerm(ctx context.Context, in *CreateVocabularyTermRequest, opts ...grpc.CallOption) (*CreateVocabularyTermResponse, error) {
+	out := new(CreateVocabularyTermResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/CreateVocabularyTerm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) UpdateVocabularyTerm(ctx context.Context, in *UpdateVocabularyTermRequest, opts ...grpc.CallOption) (*UpdateVocabularyTermResponse, error) {
+	out := new(UpdateVocabularyTermResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/UpdateVocabularyTerm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) DeleteVocabularyTerm(ctx context.Context, in *DeleteVocabularyTermRequest, opts ...grpc.CallOption) (*DeleteVocabularyTermResponse, error) {
+	out := new(DeleteVocabularyTermResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/DeleteVocabularyTerm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) ListVocabularyViolations(ctx context.Context, in *ListVocabularyViolationsRequest, opts ...grpc.CallOption) (*ListVocabularyViolationsResponse, error) {
+	out := new(ListVocabularyViolationsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ListVocabularyViolations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) BatchCreateAuthors(ctx context.Context, in *BatchCreateAuthorsRequest, opts ...grpc.CallOption) (*BatchCreateAuthorsResponse, error) {
+	out := new(BatchCreateAuthorsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/BatchCreateAuthors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) BatchUpdateAuthors(ctx context.Context, in *BatchUpdateAuthorsRequest, opts ...grpc.CallOption) (*BatchUpdateAuthorsResponse, error) {
+	out := new(BatchUpdateAuthorsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/BatchUpdateAuthors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) BatchCreateIP_Assets(ctx context.Context, in *BatchCreateIP_AssetsRequest, opts ...grpc.CallOption) (*BatchCreateIP_AssetsResponse, error) {
+	out := new(BatchCreateIP_AssetsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/BatchCreateIP_Assets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) BatchUpdateIP_Assets(ctx context.Context, in *BatchUpdateIP_AssetsRequest, opts ...grpc.CallOption) (*BatchUpdateIP_AssetsResponse, error) {
+	out := new(BatchUpdateIP_AssetsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/BatchUpdateIP_Assets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) BatchCreatePublications(ctx context.Context, in *BatchCreatePublicationsRequest, opts ...grpc.CallOption) (*BatchCreatePublicationsResponse, error) {
+	out := new(BatchCreatePublicationsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/BatchCreatePublications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) BatchUpdatePublications(ctx context.Context, in *BatchUpdatePublicationsRequest, opts ...grpc.CallOption) (*BatchUpdatePublicationsResponse, error) {
+	out := new(BatchUpdatePublicationsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/BatchUpdatePublications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) BatchCreateUsers(ctx context.Context, in *BatchCreateUsersRequest, opts ...grpc.CallOption) (*BatchCreateUsersResponse, error) {
+	out := new(BatchCreateUsersResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/BatchCreateUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) BatchUpdateUsers(ctx context.Context, in *BatchUpdateUsersRequest, opts ...grpc.CallOption) (*BatchUpdateUsersResponse, error) {
+	out := new(BatchUpdateUsersResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/BatchUpdateUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) ImportRecords(ctx context.Context, in *ImportRecordsRequest, opts ...grpc.CallOption) (*ImportRecordsResponse, error) {
+	out := new(ImportRecordsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ImportRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) ExportRecords(ctx context.Context, in *ExportRecordsRequest, opts ...grpc.CallOption) (RMSService_ExportRecordsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RMSService_ServiceDesc.Streams[0], "/proto.RMSService/ExportRecords", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rMSServiceExportRecordsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RMSService_ExportRecordsClient interface {
+	Recv() (*ExportRecordsChunk, error)
+	grpc.ClientStream
+}
+
+type rMSServiceExportRecordsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rMSServiceExportRecordsClient) Recv() (*ExportRecordsChunk, error) {
+	m := new(ExportRecordsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rMSServiceClient) ExportCitations(ctx context.Context, in *ExportCitationsRequest, opts ...grpc.CallOption) (*ExportCitationsResponse, error) {
+	out := new(ExportCitationsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ExportCitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rMSServiceClient) ImportCitations(ctx context.Context, in *ImportCitationsRequest, opts ...grpc.CallOption) (*ImportCitationsResponse, error) {
+	out := new(ImportCitationsResponse)
+	err := c.cc.Invoke(ctx, "/proto.RMSService/ImportCitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RMSServiceServer is the server API for RMSService service.
 // All implementations must embed UnimplementedRMSServiceServer
 // for forward compatibility
@@ -291,16 +674,22 @@ type RMSServiceServer interface {
 	GetAuthors(context.Context, *ReadAuthorsRequest) (*ReadAuthorsResponse, error)
 	UpdateAuthor(context.Context, *UpdateAuthorRequest) (*UpdateAuthorResponse, error)
 	DeleteAuthor(context.Context, *DeleteAuthorRequest) (*DeleteAuthorResponse, error)
+	ListDeletedAuthors(context.Context, *ReadAuthorsRequest) (*ReadAuthorsResponse, error)
+	RestoreAuthor(context.Context, *RestoreAuthorRequest) (*RestoreAuthorResponse, error)
 	CreateIP_Asset(context.Context, *CreateIP_AssetRequest) (*CreateIP_AssetResponse, error)
 	GetIP_Asset(context.Context, *ReadIP_AssetRequest) (*ReadIP_AssetResponse, error)
 	GetIP_Assets(context.Context, *ReadIP_AssetsRequest) (*ReadIP_AssetsResponse, error)
 	UpdateIP_Asset(context.Context, *UpdateIP_AssetRequest) (*UpdateIP_AssetResponse, error)
 	DeleteIP_Asset(context.Context, *DeleteIP_AssetRequest) (*DeleteIP_AssetResponse, error)
+	ListDeletedIP_Assets(context.Context, *ReadIP_AssetsRequest) (*ReadIP_AssetsResponse, error)
+	RestoreIP_Asset(context.Context, *RestoreIP_AssetRequest) (*RestoreIP_AssetResponse, error)
 	CreatePublication(context.Context, *CreatePublicationRequest) (*CreatePublicationResponse, error)
 	GetPublication(context.Context, *ReadPublicationRequest) (*ReadPublicationResponse, error)
 	GetPublications(context.Context, *ReadPublicationsRequest) (*ReadPublicationsResponse, error)
 	UpdatePublication(context.Context, *UpdatePublicationRequest) (*UpdatePublicationResponse, error)
 	DeletePublication(context.Context, *DeletePublicationRequest) (*DeletePublicationResponse, error)
+	ListDeletedPublications(context.Context, *ReadPublicationsRequest) (*ReadPublicationsResponse, error)
+	RestorePublication(context.Context, *RestorePublicationRequest) (*RestorePublicationResponse, error)
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	GetUser(context.Context, *ReadUserRequest) (*ReadUserResponse, error)
 	GetUsers(context.Context, *ReadUsersRequest) (*ReadUsersResponse, error)
@@ -311,6 +700,36 @@ type RMSServiceServer interface {
 	GetLogs(context.Context, *ReadLogsRequest) (*ReadLogsResponse, error)
 	UpdateLog(context.Context, *UpdateLogRequest) (*UpdateLogResponse, error)
 	DeleteLog(context.Context, *DeleteLogRequest) (*DeleteLogResponse, error)
+	LinkPublicationAuthor(context.Context, *LinkPublicationAuthorRequest) (*LinkPublicationAuthorResponse, error)
+	UnlinkPublicationAuthor(context.Context, *UnlinkPublicationAuthorRequest) (*UnlinkPublicationAuthorResponse, error)
+	LinkIP_AssetAuthor(context.Context, *LinkIP_AssetAuthorRequest) (*LinkIP_AssetAuthorResponse, error)
+	UnlinkIP_AssetAuthor(context.Context, *UnlinkIP_AssetAuthorRequest) (*UnlinkIP_AssetAuthorResponse, error)
+	ListAuthorPublications(context.Context, *ListAuthorPublicationsRequest) (*ListAuthorPublicationsResponse, error)
+	ListAuthorIP_Assets(context.Context, *ListAuthorIP_AssetsRequest) (*ListAuthorIP_AssetsResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	VerifyLogChain(context.Context, *VerifyLogChainRequest) (*VerifyLogChainResponse, error)
+	ExportLogCheckpoint(context.Context, *ExportLogCheckpointRequest) (*ExportLogCheckpointResponse, error)
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error)
+	RevertToRevision(context.Context, *RevertToRevisionRequest) (*RevertToRevisionResponse, error)
+	ListVocabulary(context.Context, *ListVocabularyRequest) (*ListVocabularyResponse, error)
+	CreateVocabularyTerm(context.Context, *CreateVocabularyTermRequest) (*CreateVocabularyTermResponse, error)
+	UpdateVocabularyTerm(context.Context, *UpdateVocabularyTermRequest) (*UpdateVocabularyTermResponse, error)
+	DeleteVocabularyTerm(context.Context, *DeleteVocabularyTermRequest) (*DeleteVocabularyTermResponse, error)
+	ListVocabularyViolations(context.Context, *ListVocabularyViolationsRequest) (*ListVocabularyViolationsResponse, error)
+	BatchCreateAuthors(context.Context, *BatchCreateAuthorsRequest) (*BatchCreateAuthorsResponse, error)
+	BatchUpdateAuthors(context.Context, *BatchUpdateAuthorsRequest) (*BatchUpdateAuthorsResponse, error)
+	BatchCreateIP_Assets(context.Context, *BatchCreateIP_AssetsRequest) (*BatchCreateIP_AssetsResponse, error)
+	BatchUpdateIP_Assets(context.Context, *BatchUpdateIP_AssetsRequest) (*BatchUpdateIP_AssetsResponse, error)
+	BatchCreatePublications(context.Context, *BatchCreatePublicationsRequest) (*BatchCreatePublicationsResponse, error)
+	BatchUpdatePublications(context.Context, *BatchUpdatePublicationsRequest) (*BatchUpdatePublicationsResponse, error)
+	BatchCreateUsers(context.Context, *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error)
+	BatchUpdateUsers(context.Context, *BatchUpdateUsersRequest) (*BatchUpdateUsersResponse, error)
+	ImportRecords(context.Context, *ImportRecordsRequest) (*ImportRecordsResponse, error)
+	ExportRecords(*ExportRecordsRequest, RMSService_ExportRecordsServer) error
+	ExportCitations(context.Context, *ExportCitationsRequest) (*ExportCitationsResponse, error)
+	ImportCitations(context.Context, *ImportCitationsRequest) (*ImportCitationsResponse, error)
 	mustEmbedUnimplementedRMSServiceServer()
 }
 
@@ -333,6 +752,12 @@ func (UnimplementedRMSServiceServer) UpdateAuthor(context.Context, *UpdateAuthor
 func (UnimplementedRMSServiceServer) DeleteAuthor(context.Context, *DeleteAuthorRequest) (*DeleteAuthorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuthor not implemented")
 }
+func (UnimplementedRMSServiceServer) ListDeletedAuthors(context.Context, *ReadAuthorsRequest) (*ReadAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedAuthors not implemented")
+}
+func (UnimplementedRMSServiceServer) RestoreAuthor(context.Context, *RestoreAuthorRequest) (*RestoreAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAuthor not implemented")
+}
 func (UnimplementedRMSServiceServer) CreateIP_Asset(context.Context, *CreateIP_AssetRequest) (*CreateIP_AssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIP_Asset not implemented")
 }
@@ -348,6 +773,12 @@ func (UnimplementedRMSServiceServer) UpdateIP_Asset(context.Context, *UpdateIP_A
 func (UnimplementedRMSServiceServer) DeleteIP_Asset(context.Context, *DeleteIP_AssetRequest) (*DeleteIP_AssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteIP_Asset not implemented")
 }
+func (UnimplementedRMSServiceServer) ListDeletedIP_Assets(context.Context, *ReadIP_AssetsRequest) (*ReadIP_AssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedIP_Assets not implemented")
+}
+func (UnimplementedRMSServiceServer) RestoreIP_Asset(context.Context, *RestoreIP_AssetRequest) (*RestoreIP_AssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreIP_Asset not implemented")
+}
 func (UnimplementedRMSServiceServer) CreatePublication(context.Context, *CreatePublicationRequest) (*CreatePublicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePublication not implemented")
 }
@@ -363,6 +794,12 @@ func (UnimplementedRMSServiceServer) UpdatePublication(context.Context, *UpdateP
 func (UnimplementedRMSServiceServer) DeletePublication(context.Context, *DeletePublicationRequest) (*DeletePublicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePublication not implemented")
 }
+func (UnimplementedRMSServiceServer) ListDeletedPublications(context.Context, *ReadPublicationsRequest) (*ReadPublicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedPublications not implemented")
+}
+func (UnimplementedRMSServiceServer) RestorePublication(context.Context, *RestorePublicationRequest) (*RestorePublicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePublication not implemented")
+}
 func (UnimplementedRMSServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
@@ -390,468 +827,1209 @@ func (UnimplementedRMSServiceServer) GetLogs(context.Context, *ReadLogsRequest)
 func (UnimplementedRMSServiceServer) UpdateLog(context.Context, *UpdateLogRequest) (*UpdateLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLog not implemented")
 }
-func (UnimplementedRMSServiceServer) DeleteLog(context.Context, *DeleteLogRequest) (*DeleteLogResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteLog not implemented")
+func (UnimplementedRMSServiceServer) DeleteLog(context.Context, *DeleteLogRequest) (*DeleteLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLog not implemented")
+}
+func (UnimplementedRMSServiceServer) LinkPublicationAuthor(context.Context, *LinkPublicationAuthorRequest) (*LinkPublicationAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkPublicationAuthor not implemented")
+}
+func (UnimplementedRMSServiceServer) UnlinkPublicationAuthor(context.Context, *UnlinkPublicationAuthorRequest) (*UnlinkPublicationAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkPublicationAuthor not implemented")
+}
+func (UnimplementedRMSServiceServer) LinkIP_AssetAuthor(context.Context, *LinkIP_AssetAuthorRequest) (*LinkIP_AssetAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkIP_AssetAuthor not implemented")
+}
+func (UnimplementedRMSServiceServer) UnlinkIP_AssetAuthor(context.Context, *UnlinkIP_AssetAuthorRequest) (*UnlinkIP_AssetAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkIP_AssetAuthor not implemented")
+}
+func (UnimplementedRMSServiceServer) ListAuthorPublications(context.Context, *ListAuthorPublicationsRequest) (*ListAuthorPublicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorPublications not implemented")
+}
+func (UnimplementedRMSServiceServer) ListAuthorIP_Assets(context.Context, *ListAuthorIP_AssetsRequest) (*ListAuthorIP_AssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorIP_Assets not implemented")
+}
+func (UnimplementedRMSServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedRMSServiceServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+}
+func (UnimplementedRMSServiceServer) VerifyLogChain(context.Context, *VerifyLogChainRequest) (*VerifyLogChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLogChain not implemented")
+}
+func (UnimplementedRMSServiceServer) ExportLogCheckpoint(context.Context, *ExportLogCheckpointRequest) (*ExportLogCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportLogCheckpoint not implemented")
+}
+func (UnimplementedRMSServiceServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedRMSServiceServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedRMSServiceServer) RevertToRevision(context.Context, *RevertToRevisionRequest) (*RevertToRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertToRevision not implemented")
+}
+func (UnimplementedRMSServiceServer) ListVocabulary(context.Context, *ListVocabularyRequest) (*ListVocabularyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVocabulary not implemented")
+}
+func (UnimplementedRMSServiceServer) CreateVocabularyTerm(context.Context, *CreateVocabularyTermRequest) (*CreateVocabularyTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVocabularyTerm not implemented")
+}
+func (UnimplementedRMSServiceServer) UpdateVocabularyTerm(context.Context, *UpdateVocabularyTermRequest) (*UpdateVocabularyTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVocabularyTerm not implemented")
+}
+func (UnimplementedRMSServiceServer) DeleteVocabularyTerm(context.Context, *DeleteVocabularyTermRequest) (*DeleteVocabularyTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVocabularyTerm not implemented")
+}
+func (UnimplementedRMSServiceServer) ListVocabularyViolations(context.Context, *ListVocabularyViolationsRequest) (*ListVocabularyViolationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVocabularyViolations not implemented")
+}
+func (UnimplementedRMSServiceServer) BatchCreateAuthors(context.Context, *BatchCreateAuthorsRequest) (*BatchCreateAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateAuthors not implemented")
+}
+func (UnimplementedRMSServiceServer) BatchUpdateAuthors(context.Context, *BatchUpdateAuthorsRequest) (*BatchUpdateAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateAuthors not implemented")
+}
+func (UnimplementedRMSServiceServer) BatchCreateIP_Assets(context.Context, *BatchCreateIP_AssetsRequest) (*BatchCreateIP_AssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateIP_Assets not implemented")
+}
+func (UnimplementedRMSServiceServer) BatchUpdateIP_Assets(context.Context, *BatchUpdateIP_AssetsRequest) (*BatchUpdateIP_AssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateIP_Assets not implemented")
+}
+func (UnimplementedRMSServiceServer) BatchCreatePublications(context.Context, *BatchCreatePublicationsRequest) (*BatchCreatePublicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreatePublications not implemented")
+}
+func (UnimplementedRMSServiceServer) BatchUpdatePublications(context.Context, *BatchUpdatePublicationsRequest) (*BatchUpdatePublicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdatePublications not implemented")
+}
+func (UnimplementedRMSServiceServer) BatchCreateUsers(context.Context, *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateUsers not implemented")
+}
+func (UnimplementedRMSServiceServer) BatchUpdateUsers(context.Context, *BatchUpdateUsersRequest) (*BatchUpdateUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateUsers not implemented")
+}
+func (UnimplementedRMSServiceServer) ImportRecords(context.Context, *ImportRecordsRequest) (*ImportRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRecords not implemented")
+}
+func (UnimplementedRMSServiceServer) ExportRecords(*ExportRecordsRequest, RMSService_ExportRecordsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportRecords not implemented")
+}
+func (UnimplementedRMSServiceServer) ExportCitations(context.Context, *ExportCitationsRequest) (*ExportCitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCitations not implemented")
+}
+func (UnimplementedRMSServiceServer) ImportCitations(context.Context, *ImportCitationsRequest) (*ImportCitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCitations not implemented")
+}
+func (UnimplementedRMSServiceServer) mustEmbedUnimplementedRMSServiceServer() {}
+
+// UnsafeRMSServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RMSServiceServer will
+// result in compilation errors.
+type UnsafeRMSServiceServer interface {
+	mustEmbedUnimplementedRMSServiceServer()
+}
+
+func RegisterRMSServiceServer(s grpc.ServiceRegistrar, srv RMSServiceServer) {
+	s.RegisterService(&RMSService_ServiceDesc, srv)
+}
+
+func _RMSService_CreateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).CreateAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/CreateAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).CreateAuthor(ctx, req.(*CreateAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_GetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).GetAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/GetAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).GetAuthor(ctx, req.(*ReadAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_GetAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).GetAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/GetAuthors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).GetAuthors(ctx, req.(*ReadAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_UpdateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).UpdateAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/UpdateAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).UpdateAuthor(ctx, req.(*UpdateAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_DeleteAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).DeleteAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/DeleteAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).DeleteAuthor(ctx, req.(*DeleteAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_ListDeletedAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).ListDeletedAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/ListDeletedAuthors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).ListDeletedAuthors(ctx, req.(*ReadAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_RestoreAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).RestoreAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/RestoreAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).RestoreAuthor(ctx, req.(*RestoreAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_CreateIP_Asset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIP_AssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).CreateIP_Asset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/CreateIP_Asset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).CreateIP_Asset(ctx, req.(*CreateIP_AssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_GetIP_Asset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadIP_AssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).GetIP_Asset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/GetIP_Asset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).GetIP_Asset(ctx, req.(*ReadIP_AssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_GetIP_Assets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadIP_AssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).GetIP_Assets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/GetIP_Assets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).GetIP_Assets(ctx, req.(*ReadIP_AssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_UpdateIP_Asset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIP_AssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).UpdateIP_Asset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/UpdateIP_Asset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).UpdateIP_Asset(ctx, req.(*UpdateIP_AssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_DeleteIP_Asset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIP_AssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).DeleteIP_Asset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/DeleteIP_Asset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).DeleteIP_Asset(ctx, req.(*DeleteIP_AssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_ListDeletedIP_Assets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadIP_AssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).ListDeletedIP_Assets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/ListDeletedIP_Assets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).ListDeletedIP_Assets(ctx, req.(*ReadIP_AssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_RestoreIP_Asset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreIP_AssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).RestoreIP_Asset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/RestoreIP_Asset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).RestoreIP_Asset(ctx, req.(*RestoreIP_AssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_CreatePublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePublicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).CreatePublication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/CreatePublication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).CreatePublication(ctx, req.(*CreatePublicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_GetPublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPublicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).GetPublication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/GetPublication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).GetPublication(ctx, req.(*ReadPublicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_GetPublications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPublicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).GetPublications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/GetPublications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).GetPublications(ctx, req.(*ReadPublicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_UpdatePublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePublicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).UpdatePublication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/UpdatePublication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).UpdatePublication(ctx, req.(*UpdatePublicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_DeletePublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePublicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).DeletePublication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/DeletePublication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).DeletePublication(ctx, req.(*DeletePublicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_ListDeletedPublications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPublicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).ListDeletedPublications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/ListDeletedPublications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).ListDeletedPublications(ctx, req.(*ReadPublicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_RestorePublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePublicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).RestorePublication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/RestorePublication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).RestorePublication(ctx, req.(*RestorePublicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).GetUser(ctx, req.(*ReadUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/GetUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).GetUsers(ctx, req.(*ReadUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_CreateLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).CreateLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/CreateLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).CreateLog(ctx, req.(*CreateLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_GetLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).GetLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/GetLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).GetLog(ctx, req.(*ReadLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/GetLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).GetLogs(ctx, req.(*ReadLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_UpdateLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).UpdateLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/UpdateLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).UpdateLog(ctx, req.(*UpdateLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_DeleteLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).DeleteLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/DeleteLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).DeleteLog(ctx, req.(*DeleteLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_LinkPublicationAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkPublicationAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).LinkPublicationAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/LinkPublicationAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).LinkPublicationAuthor(ctx, req.(*LinkPublicationAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RMSService_UnlinkPublicationAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkPublicationAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).UnlinkPublicationAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/UnlinkPublicationAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).UnlinkPublicationAuthor(ctx, req.(*UnlinkPublicationAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRMSServiceServer) mustEmbedUnimplementedRMSServiceServer() {}
 
-// UnsafeRMSServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RMSServiceServer will
-// result in compilation errors.
-type UnsafeRMSServiceServer interface {
-	mustEmbedUnimplementedRMSServiceServer()
+func _RMSService_LinkIP_AssetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIP_AssetAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).LinkIP_AssetAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/LinkIP_AssetAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).LinkIP_AssetAuthor(ctx, req.(*LinkIP_AssetAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRMSServiceServer(s grpc.ServiceRegistrar, srv RMSServiceServer) {
-	s.RegisterService(&RMSService_ServiceDesc, srv)
+func _RMSService_UnlinkIP_AssetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIP_AssetAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RMSServiceServer).UnlinkIP_AssetAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RMSService/UnlinkIP_AssetAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RMSServiceServer).UnlinkIP_AssetAuthor(ctx, req.(*UnlinkIP_AssetAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_CreateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAuthorRequest)
+func _RMSService_ListAuthorPublications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorPublicationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).CreateAuthor(ctx, in)
+		return srv.(RMSServiceServer).ListAuthorPublications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/CreateAuthor",
+		FullMethod: "/proto.RMSService/ListAuthorPublications",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).CreateAuthor(ctx, req.(*CreateAuthorRequest))
+		return srv.(RMSServiceServer).ListAuthorPublications(ctx, req.(*ListAuthorPublicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_GetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadAuthorRequest)
+func _RMSService_ListAuthorIP_Assets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorIP_AssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).GetAuthor(ctx, in)
+		return srv.(RMSServiceServer).ListAuthorIP_Assets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/GetAuthor",
+		FullMethod: "/proto.RMSService/ListAuthorIP_Assets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).GetAuthor(ctx, req.(*ReadAuthorRequest))
+		return srv.(RMSServiceServer).ListAuthorIP_Assets(ctx, req.(*ListAuthorIP_AssetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_GetAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadAuthorsRequest)
+func _RMSService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).GetAuthors(ctx, in)
+		return srv.(RMSServiceServer).Search(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/GetAuthors",
+		FullMethod: "/proto.RMSService/Search",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).GetAuthors(ctx, req.(*ReadAuthorsRequest))
+		return srv.(RMSServiceServer).Search(ctx, req.(*SearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_UpdateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAuthorRequest)
+func _RMSService_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).UpdateAuthor(ctx, in)
+		return srv.(RMSServiceServer).Authenticate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/UpdateAuthor",
+		FullMethod: "/proto.RMSService/Authenticate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).UpdateAuthor(ctx, req.(*UpdateAuthorRequest))
+		return srv.(RMSServiceServer).Authenticate(ctx, req.(*AuthenticateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_DeleteAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAuthorRequest)
+func _RMSService_VerifyLogChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLogChainRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).DeleteAuthor(ctx, in)
+		return srv.(RMSServiceServer).VerifyLogChain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/DeleteAuthor",
+		FullMethod: "/proto.RMSService/VerifyLogChain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).DeleteAuthor(ctx, req.(*DeleteAuthorRequest))
+		return srv.(RMSServiceServer).VerifyLogChain(ctx, req.(*VerifyLogChainRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_CreateIP_Asset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateIP_AssetRequest)
+func _RMSService_ExportLogCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportLogCheckpointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).CreateIP_Asset(ctx, in)
+		return srv.(RMSServiceServer).ExportLogCheckpoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/CreateIP_Asset",
+		FullMethod: "/proto.RMSService/ExportLogCheckpoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).CreateIP_Asset(ctx, req.(*CreateIP_AssetRequest))
+		return srv.(RMSServiceServer).ExportLogCheckpoint(ctx, req.(*ExportLogCheckpointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_GetIP_Asset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadIP_AssetRequest)
+func _RMSService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).GetIP_Asset(ctx, in)
+		return srv.(RMSServiceServer).ListRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/GetIP_Asset",
+		FullMethod: "/proto.RMSService/ListRevisions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).GetIP_Asset(ctx, req.(*ReadIP_AssetRequest))
+		return srv.(RMSServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_GetIP_Assets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadIP_AssetsRequest)
+func _RMSService_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).GetIP_Assets(ctx, in)
+		return srv.(RMSServiceServer).DiffRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/GetIP_Assets",
+		FullMethod: "/proto.RMSService/DiffRevisions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).GetIP_Assets(ctx, req.(*ReadIP_AssetsRequest))
+		return srv.(RMSServiceServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_UpdateIP_Asset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateIP_AssetRequest)
+func _RMSService_RevertToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertToRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).UpdateIP_Asset(ctx, in)
+		return srv.(RMSServiceServer).RevertToRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/UpdateIP_Asset",
+		FullMethod: "/proto.RMSService/RevertToRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).UpdateIP_Asset(ctx, req.(*UpdateIP_AssetRequest))
+		return srv.(RMSServiceServer).RevertToRevision(ctx, req.(*RevertToRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_DeleteIP_Asset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteIP_AssetRequest)
+func _RMSService_ListVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVocabularyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).DeleteIP_Asset(ctx, in)
+		return srv.(RMSServiceServer).ListVocabulary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/DeleteIP_Asset",
+		FullMethod: "/proto.RMSService/ListVocabulary",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).DeleteIP_Asset(ctx, req.(*DeleteIP_AssetRequest))
+		return srv.(RMSServiceServer).ListVocabulary(ctx, req.(*ListVocabularyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_CreatePublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePublicationRequest)
+func _RMSService_CreateVocabularyTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVocabularyTermRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).CreatePublication(ctx, in)
+		return srv.(RMSServiceServer).CreateVocabularyTerm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/CreatePublication",
+		FullMethod: "/proto.RMSService/CreateVocabularyTerm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).CreatePublication(ctx, req.(*CreatePublicationRequest))
+		return srv.(RMSServiceServer).CreateVocabularyTerm(ctx, req.(*CreateVocabularyTermRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_GetPublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadPublicationRequest)
+func _RMSService_UpdateVocabularyTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVocabularyTermRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).GetPublication(ctx, in)
+		return srv.(RMSServiceServer).UpdateVocabularyTerm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/GetPublication",
+		FullMethod: "/proto.RMSService/UpdateVocabularyTerm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).GetPublication(ctx, req.(*ReadPublicationRequest))
+		return srv.(RMSServiceServer).UpdateVocabularyTerm(ctx, req.(*UpdateVocabularyTermRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_GetPublications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadPublicationsRequest)
+func _RMSService_DeleteVocabularyTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVocabularyTermRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).GetPublications(ctx, in)
+		return srv.(RMSServiceServer).DeleteVocabularyTerm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/GetPublications",
+		FullMethod: "/proto.RMSService/DeleteVocabularyTerm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).GetPublications(ctx, req.(*ReadPublicationsRequest))
+		return srv.(RMSServiceServer).DeleteVocabularyTerm(ctx, req.(*DeleteVocabularyTermRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_UpdatePublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePublicationRequest)
+func _RMSService_ListVocabularyViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVocabularyViolationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).UpdatePublication(ctx, in)
+		return srv.(RMSServiceServer).ListVocabularyViolations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/UpdatePublication",
+		FullMethod: "/proto.RMSService/ListVocabularyViolations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).UpdatePublication(ctx, req.(*UpdatePublicationRequest))
+		return srv.(RMSServiceServer).ListVocabularyViolations(ctx, req.(*ListVocabularyViolationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_DeletePublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePublicationRequest)
+func _RMSService_BatchCreateAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateAuthorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).DeletePublication(ctx, in)
+		return srv.(RMSServiceServer).BatchCreateAuthors(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/DeletePublication",
+		FullMethod: "/proto.RMSService/BatchCreateAuthors",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).DeletePublication(ctx, req.(*DeletePublicationRequest))
+		return srv.(RMSServiceServer).BatchCreateAuthors(ctx, req.(*BatchCreateAuthorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _RMSService_BatchUpdateAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateAuthorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).CreateUser(ctx, in)
+		return srv.(RMSServiceServer).BatchUpdateAuthors(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/CreateUser",
+		FullMethod: "/proto.RMSService/BatchUpdateAuthors",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(RMSServiceServer).BatchUpdateAuthors(ctx, req.(*BatchUpdateAuthorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadUserRequest)
+func _RMSService_BatchCreateIP_Assets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateIP_AssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).GetUser(ctx, in)
+		return srv.(RMSServiceServer).BatchCreateIP_Assets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/GetUser",
+		FullMethod: "/proto.RMSService/BatchCreateIP_Assets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).GetUser(ctx, req.(*ReadUserRequest))
+		return srv.(RMSServiceServer).BatchCreateIP_Assets(ctx, req.(*BatchCreateIP_AssetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadUsersRequest)
+func _RMSService_BatchUpdateIP_Assets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateIP_AssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).GetUsers(ctx, in)
+		return srv.(RMSServiceServer).BatchUpdateIP_Assets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/GetUsers",
+		FullMethod: "/proto.RMSService/BatchUpdateIP_Assets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).GetUsers(ctx, req.(*ReadUsersRequest))
+		return srv.(RMSServiceServer).BatchUpdateIP_Assets(ctx, req.(*BatchUpdateIP_AssetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _RMSService_BatchCreatePublications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreatePublicationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).UpdateUser(ctx, in)
+		return srv.(RMSServiceServer).BatchCreatePublications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/UpdateUser",
+		FullMethod: "/proto.RMSService/BatchCreatePublications",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(RMSServiceServer).BatchCreatePublications(ctx, req.(*BatchCreatePublicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _RMSService_BatchUpdatePublications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdatePublicationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).DeleteUser(ctx, in)
+		return srv.(RMSServiceServer).BatchUpdatePublications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/DeleteUser",
+		FullMethod: "/proto.RMSService/BatchUpdatePublications",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(RMSServiceServer).BatchUpdatePublications(ctx, req.(*BatchUpdatePublicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_CreateLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateLogRequest)
+func _RMSService_BatchCreateUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).CreateLog(ctx, in)
+		return srv.(RMSServiceServer).BatchCreateUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/CreateLog",
+		FullMethod: "/proto.RMSService/BatchCreateUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).CreateLog(ctx, req.(*CreateLogRequest))
+		return srv.(RMSServiceServer).BatchCreateUsers(ctx, req.(*BatchCreateUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_GetLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadLogRequest)
+func _RMSService_BatchUpdateUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).GetLog(ctx, in)
+		return srv.(RMSServiceServer).BatchUpdateUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/GetLog",
+		FullMethod: "/proto.RMSService/BatchUpdateUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).GetLog(ctx, req.(*ReadLogRequest))
+		return srv.(RMSServiceServer).BatchUpdateUsers(ctx, req.(*BatchUpdateUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadLogsRequest)
+func _RMSService_ImportRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRecordsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).GetLogs(ctx, in)
+		return srv.(RMSServiceServer).ImportRecords(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/GetLogs",
+		FullMethod: "/proto.RMSService/ImportRecords",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).GetLogs(ctx, req.(*ReadLogsRequest))
+		return srv.(RMSServiceServer).ImportRecords(ctx, req.(*ImportRecordsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_UpdateLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLogRequest)
+func _RMSService_ExportRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRecordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RMSServiceServer).ExportRecords(m, &rMSServiceExportRecordsServer{stream})
+}
+
+type RMSService_ExportRecordsServer interface {
+	Send(*ExportRecordsChunk) error
+	grpc.ServerStream
+}
+
+type rMSServiceExportRecordsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rMSServiceExportRecordsServer) Send(m *ExportRecordsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RMSService_ExportCitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCitationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).UpdateLog(ctx, in)
+		return srv.(RMSServiceServer).ExportCitations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/UpdateLog",
+		FullMethod: "/proto.RMSService/ExportCitations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).UpdateLog(ctx, req.(*UpdateLogRequest))
+		return srv.(RMSServiceServer).ExportCitations(ctx, req.(*ExportCitationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RMSService_DeleteLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteLogRequest)
+func _RMSService_ImportCitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCitationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RMSServiceServer).DeleteLog(ctx, in)
+		return srv.(RMSServiceServer).ImportCitations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RMSService/DeleteLog",
+		FullMethod: "/proto.RMSService/ImportCitations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RMSServiceServer).DeleteLog(ctx, req.(*DeleteLogRequest))
+		return srv.(RMSServiceServer).ImportCitations(ctx, req.(*ImportCitationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -883,6 +2061,14 @@ var RMSService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAuthor",
 			Handler:    _RMSService_DeleteAuthor_Handler,
 		},
+		{
+			MethodName: "ListDeletedAuthors",
+			Handler:    _RMSService_ListDeletedAuthors_Handler,
+		},
+		{
+			MethodName: "RestoreAuthor",
+			Handler:    _RMSService_RestoreAuthor_Handler,
+		},
 		{
 			MethodName: "CreateIP_Asset",
 			Handler:    _RMSService_CreateIP_Asset_Handler,
@@ -903,6 +2089,14 @@ var RMSService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteIP_Asset",
 			Handler:    _RMSService_DeleteIP_Asset_Handler,
 		},
+		{
+			MethodName: "ListDeletedIP_Assets",
+			Handler:    _RMSService_ListDeletedIP_Assets_Handler,
+		},
+		{
+			MethodName: "RestoreIP_Asset",
+			Handler:    _RMSService_RestoreIP_Asset_Handler,
+		},
 		{
 			MethodName: "CreatePublication",
 			Handler:    _RMSService_CreatePublication_Handler,
@@ -923,6 +2117,14 @@ var RMSService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePublication",
 			Handler:    _RMSService_DeletePublication_Handler,
 		},
+		{
+			MethodName: "ListDeletedPublications",
+			Handler:    _RMSService_ListDeletedPublications_Handler,
+		},
+		{
+			MethodName: "RestorePublication",
+			Handler:    _RMSService_RestorePublication_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _RMSService_CreateUser_Handler,
@@ -963,7 +2165,129 @@ var RMSService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLog",
 			Handler:    _RMSService_DeleteLog_Handler,
 		},
+		{
+			MethodName: "LinkPublicationAuthor",
+			Handler:    _RMSService_LinkPublicationAuthor_Handler,
+		},
+		{
+			MethodName: "UnlinkPublicationAuthor",
+			Handler:    _RMSService_UnlinkPublicationAuthor_Handler,
+		},
+		{
+			MethodName: "LinkIP_AssetAuthor",
+			Handler:    _RMSService_LinkIP_AssetAuthor_Handler,
+		},
+		{
+			MethodName: "UnlinkIP_AssetAuthor",
+			Handler:    _RMSService_UnlinkIP_AssetAuthor_Handler,
+		},
+		{
+			MethodName: "ListAuthorPublications",
+			Handler:    _RMSService_ListAuthorPublications_Handler,
+		},
+		{
+			MethodName: "ListAuthorIP_Assets",
+			Handler:    _RMSService_ListAuthorIP_Assets_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _RMSService_Search_Handler,
+		},
+		{
+			MethodName: "Authenticate",
+			Handler:    _RMSService_Authenticate_Handler,
+		},
+		{
+			MethodName: "VerifyLogChain",
+			Handler:    _RMSService_VerifyLogChain_Handler,
+		},
+		{
+			MethodName: "ExportLogCheckpoint",
+			Handler:    _RMSService_ExportLogCheckpoint_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _RMSService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _RMSService_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RevertToRevision",
+			Handler:    _RMSService_RevertToRevision_Handler,
+		},
+		{
+			MethodName: "ListVocabulary",
+			Handler:    _RMSService_ListVocabulary_Handler,
+		},
+		{
+			MethodName: "CreateVocabularyTerm",
+			Handler:    _RMSService_CreateVocabularyTerm_Handler,
+		},
+		{
+			MethodName: "UpdateVocabularyTerm",
+			Handler:    _RMSService_UpdateVocabularyTerm_Handler,
+		},
+		{
+			MethodName: "DeleteVocabularyTerm",
+			Handler:    _RMSService_DeleteVocabularyTerm_Handler,
+		},
+		{
+			MethodName: "ListVocabularyViolations",
+			Handler:    _RMSService_ListVocabularyViolations_Handler,
+		},
+		{
+			MethodName: "BatchCreateAuthors",
+			Handler:    _RMSService_BatchCreateAuthors_Handler,
+		},
+		{
+			MethodName: "BatchUpdateAuthors",
+			Handler:    _RMSService_BatchUpdateAuthors_Handler,
+		},
+		{
+			MethodName: "BatchCreateIP_Assets",
+			Handler:    _RMSService_BatchCreateIP_Assets_Handler,
+		},
+		{
+			MethodName: "BatchUpdateIP_Assets",
+			Handler:    _RMSService_BatchUpdateIP_Assets_Handler,
+		},
+		{
+			MethodName: "BatchCreatePublications",
+			Handler:    _RMSService_BatchCreatePublications_Handler,
+		},
+		{
+			MethodName: "BatchUpdatePublications",
+			Handler:    _RMSService_BatchUpdatePublications_Handler,
+		},
+		{
+			MethodName: "BatchCreateUsers",
+			Handler:    _RMSService_BatchCreateUsers_Handler,
+		},
+		{
+			MethodName: "BatchUpdateUsers",
+			Handler:    _RMSService_BatchUpdateUsers_Handler,
+		},
+		{
+			MethodName: "ImportRecords",
+			Handler:    _RMSService_ImportRecords_Handler,
+		},
+		{
+			MethodName: "ExportCitations",
+			Handler:    _RMSService_ExportCitations_Handler,
+		},
+		{
+			MethodName: "ImportCitations",
+			Handler:    _RMSService_ImportCitations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportRecords",
+			Handler:       _RMSService_ExportRecords_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/RMS.proto",
 }
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Every successful Create, Update, Delete and Restore RPC, including linking
+// and unlinking authors, appends an entry to table_log. The entry records the
+// caller, the RPC, the changed entity and the fields it changed. Purging the
+// trash is logged as well, with no caller.
+
+var errLogAppendOnly = status.Error(codes.Unimplemented, "the audit log is append-only")
+
+const (
+	actionCreate  = "create"
+	actionUpdate  = "update"
+	actionDelete  = "delete"
+	actionRestore = "restore"
+	actionPurge   = "purge"
+	// Imports report rows they leave unchanged as skipped; nothing is
+	// logged for them.
+	actionSkip = "skip"
+
+	entityAuthor      = "author"
+	entityIPAsset     = "ip_asset"
+	entityPublication = "publication"
+	entityUser        = "user"
+
+	entityVocabularyTerm = "vocabulary_term"
+)
+
+// mutationTarget names the action, entity and entity ID of a mutating
+// request. The ID is empty for creates, whose ID is assigned by the server.
+func mutationTarget(req any) (action, entity, id string, ok bool) {
+	switch r := req.(type) {
+	case *pb.CreateAuthorRequest:
+		return actionCreate, entityAuthor, "", true
+	case *pb.UpdateAuthorRequest:
+		return actionUpdate, entityAuthor, r.GetAuthor().GetAuthorId(), true
+	case *pb.DeleteAuthorRequest:
+		return actionDelete, entityAuthor, r.GetAuthorId(), true
+	case *pb.RestoreAuthorRequest:
+		return actionRestore, entityAuthor, r.GetAuthorId(), true
+	case *pb.CreateIP_AssetRequest:
+		return actionCreate, entityIPAsset, "", true
+	case *pb.UpdateIP_AssetRequest:
+		return actionUpdate, entityIPAsset, r.GetIpAsset().GetRegistrationNumber(), true
+	case *pb.DeleteIP_AssetRequest:
+		return actionDelete, entityIPAsset, r.GetRegistrationNumber(), true
+	case *pb.RestoreIP_AssetRequest:
+		return actionRestore, entityIPAsset, r.GetRegistrationNumber(), true
+	case *pb.CreatePublicationRequest:
+		return actionCreate, entityPublication, "", true
+	case *pb.UpdatePublicationRequest:
+		return actionUpdate, entityPublication, r.GetPublication().GetPublicationId(), true
+	case *pb.DeletePublicationRequest:
+		return actionDelete, entityPublication, r.GetPublicationId(), true
+	case *pb.RestorePublicationRequest:
+		return actionRestore, entityPublication, r.GetPublicationId(), true
+	case *pb.CreateUserRequest:
+		return actionCreate, entityUser, "", true
+	case *pb.UpdateUserRequest:
+		return actionUpdate, entityUser, strconv.Itoa(int(r.GetUser().GetUserId())), true
+	case *pb.DeleteUserRequest:
+		return actionDelete, entityUser, strconv.Itoa(int(r.GetUserId())), true
+	case *pb.LinkPublicationAuthorRequest:
+		return actionUpdate, entityPublication, r.GetPublicationId(), true
+	case *pb.UnlinkPublicationAuthorRequest:
+		return actionUpdate, entityPublication, r.GetPublicationId(), true
+	case *pb.LinkIP_AssetAuthorRequest:
+		return actionUpdate, entityIPAsset, r.GetRegistrationNumber(), true
+	case *pb.UnlinkIP_AssetAuthorRequest:
+		return actionUpdate, entityIPAsset, r.GetRegistrationNumber(), true
+	case *pb.RevertToRevisionRequest:
+		return actionUpdate, r.GetEntity(), r.GetEntityId(), true
+	case *pb.CreateVocabularyTermRequest:
+		return actionCreate, entityVocabularyTerm, "", true
+	case *pb.UpdateVocabularyTermRequest:
+		return actionUpdate, entityVocabularyTerm, r.GetTerm().GetVocabulary() + "/" + r.GetTerm().GetValue(), true
+	case *pb.DeleteVocabularyTermRequest:
+		return actionDelete, entityVocabularyTerm, r.GetVocabulary() + "/" + r.GetValue(), true
+	}
+	return "", "", "", false
+}
+
+// createdID returns the ID the server assigned in a create response.
+func createdID(res any) string {
+	switch r := res.(type) {
+	case *pb.CreateAuthorResponse:
+		return r.GetAuthor().GetAuthorId()
+	case *pb.CreateIP_AssetResponse:
+		return r.GetIpAsset().GetRegistrationNumber()
+	case *pb.CreatePublicationResponse:
+		return r.GetPublication().GetPublicationId()
+	case *pb.CreateUserResponse:
+		return strconv.Itoa(int(r.GetUser().GetUserId()))
+	case *pb.CreateVocabularyTermResponse:
+		return r.GetTerm().GetVocabulary() + "/" + r.GetTerm().GetValue()
+	}
+	return ""
+}
+
+// snapshot loads the current state of an entity, or nil when it does not
+// exist. Users are loaded without their password.
+func (s *server) snapshot(ctx context.Context, entity, id string) proto.Message {
+	switch entity {
+	case entityAuthor:
+		if author, err := s.authors.GetAuthor(ctx, id); err == nil {
+			return authorToProto(author)
+		}
+	case entityIPAsset:
+		if ipAsset, err := s.ipAssets.GetIPAsset(ctx, id); err == nil {
+			res := ipAssetToProto(ipAsset)
+			if err := s.withIPAssetAuthors(ctx, res); err == nil {
+				return res
+			}
+		}
+	case entityPublication:
+		if publication, err := s.publications.GetPublication(ctx, id); err == nil {
+			res := publicationToProto(publication)
+			if err := s.withPublicationAuthors(ctx, res); err == nil {
+				return res
+			}
+		}
+	case entityUser:
+		userID, err := strconv.ParseInt(id, 10, 32)
+		if err != nil {
+			return nil
+		}
+		if user, err := s.users.GetUser(ctx, int32(userID)); err == nil {
+			return userToProto(user)
+		}
+	case entityVocabularyTerm:
+		vocabulary, value, _ := strings.Cut(id, "/")
+		if term, err := s.vocabularies.GetVocabularyTerm(ctx, vocabulary, value); err == nil {
+			return vocabularyTermToProto(term)
+		}
+	}
+	return nil
+}
+
+type fieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+func messageFields(m proto.Message) map[string]any {
+	fields := map[string]any{}
+	if m == nil {
+		return fields
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	if err == nil {
+		json.Unmarshal(data, &fields)
+	}
+	return fields
+}
+
+// changedFields returns the before and after values of every field that
+// differs between the two messages, by field name. Either may be nil.
+func changedFields(before, after proto.Message) map[string]fieldChange {
+	b, a := messageFields(before), messageFields(after)
+	changes := map[string]fieldChange{}
+	for name, v := range b {
+		if !reflect.DeepEqual(v, a[name]) {
+			changes[name] = fieldChange{Before: v, After: a[name]}
+		}
+	}
+	for name, v := range a {
+		if _, ok := b[name]; !ok {
+			changes[name] = fieldChange{After: v}
+		}
+	}
+	return changes
+}
+
+// diffMessages returns changedFields as a JSON object.
+func diffMessages(before, after proto.Message) string {
+	data, _ := json.Marshal(changedFields(before, after))
+	return string(data)
+}
+
+// audit runs a mutating RPC and writes its log entry in one transaction, so
+// that the change is rolled back and the RPC fails when the entry cannot be
+// written.
+func (s *server) audit(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	action, entity, id, ok := mutationTarget(req)
+	if !ok {
+		return handler(ctx, req)
+	}
+	var res any
+	var handlerErr error
+	err := s.tx.Transaction(ctx, func(ctx context.Context) error {
+		var before proto.Message
+		if id != "" {
+			before = s.snapshot(ctx, entity, id)
+		}
+		res, handlerErr = handler(ctx, req)
+		if handlerErr != nil {
+			return handlerErr
+		}
+		if action == actionCreate {
+			id = createdID(res)
+		}
+		var after proto.Message
+		if action != actionDelete {
+			after = s.snapshot(ctx, entity, id)
+		}
+
+		entry := Log{
+			LogID:       uuid.New().String(),
+			DateTime:    logTime(),
+			Activity:    action,
+			Description: fmt.Sprintf("%s %s %s", action, entity, id),
+			Method:      path.Base(info.FullMethod),
+			Entity:      entity,
+			EntityID:    id,
+			Changes:     diffMessages(before, after),
+		}
+		if p, ok := principalFrom(ctx); ok {
+			entry.UserID = p.UserID
+		}
+		return s.logs.CreateLog(ctx, &entry)
+	})
+	if handlerErr != nil {
+		return res, handlerErr
+	}
+	if err != nil {
+		return nil, storeError(err, "log", "")
+	}
+	return res, nil
+}
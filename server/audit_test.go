@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc/codes"
+)
+
+func TestMutationsAreLogged(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as(roleAdmin)
+	created, err := call(ctx, ts, "CreateAuthor", (*server).CreateAuthor, &pb.CreateAuthorRequest{
+		Author: &pb.Author{AuthorName: "Juan Dela Cruz"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := call(ctx, ts, "GetAuthor", (*server).GetAuthor, &pb.ReadAuthorRequest{AuthorId: created.GetAuthor().GetAuthorId()}); err != nil {
+		t.Fatal(err)
+	}
+	logs, err := call(ctx, ts, "GetLogs", (*server).GetLogs, &pb.ReadLogsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := logs.GetLogs()
+	if len(l) != 1 || l[0].GetMethod() != "CreateAuthor" || l[0].GetEntityId() != created.GetAuthor().GetAuthorId() || l[0].GetUserId() != ts.users[roleAdmin].UserID {
+		t.Fatalf("logged %v, want only the create", l)
+	}
+	if !strings.Contains(l[0].GetChanges(), "Juan Dela Cruz") {
+		t.Errorf("logged changes %s", l[0].GetChanges())
+	}
+}
+
+// failingLogStore is a memory store that cannot write log entries.
+type failingLogStore struct {
+	*memoryStore
+}
+
+func (failingLogStore) CreateLog(ctx context.Context, log *Log) error {
+	return errors.New("disk full")
+}
+
+func TestMutationFailsWhenLogFails(t *testing.T) {
+	ts := newTestServer(t)
+	ts.logs = failingLogStore{ts.store}
+	_, err := call(ts.as(roleAdmin), ts, "CreateAuthor", (*server).CreateAuthor, &pb.CreateAuthorRequest{
+		Author: &pb.Author{AuthorName: "Juan Dela Cruz"},
+	})
+	wantCode(t, err, codes.Internal)
+	if n, err := ts.store.CountAuthors(context.Background(), ListOptions{}); err != nil || n != 0 {
+		t.Fatalf("an unlogged create left %d authors (%v)", n, err)
+	}
+}
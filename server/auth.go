@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Every RPC except Authenticate needs an access token, sent as
+// "authorization: Bearer <token>" metadata. Access tokens are HS256 JWTs
+// whose subject is the user ID and whose role claim is the account type the
+// user had when the token was issued.
+
+// publicMethods can be called without an access token.
+var publicMethods = map[string]bool{
+	"/proto.RMSService/Authenticate": true,
+}
+
+type tokenClaims struct {
+	Role string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// principal is the authenticated caller of an RPC.
+type principal struct {
+	UserID int32
+	Role   string
+}
+
+type principalKey struct{}
+
+func withPrincipal(ctx context.Context, p *principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func principalFrom(ctx context.Context) (*principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*principal)
+	return p, ok
+}
+
+type authenticator struct {
+	key    []byte
+	issuer string
+	ttl    time.Duration
+}
+
+func newAuthenticator(cfg AuthConfig) (*authenticator, error) {
+	key := []byte(cfg.SigningKey)
+	if len(key) == 0 {
+		key = make([]byte, minSigningKeyLength)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		log.Println("No signing key configured; access tokens will not survive a restart")
+	}
+	return &authenticator{key: key, issuer: cfg.Issuer, ttl: time.Duration(cfg.TokenTTL)}, nil
+}
+
+func (a *authenticator) issue(user *User) (string, error) {
+	now := time.Now()
+	claims := tokenClaims{
+		Role: user.AccountType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.Itoa(int(user.UserID)),
+			Issuer:    a.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(a.ttl)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(a.key)
+}
+
+func (a *authenticator) verify(token string) (*principal, error) {
+	var claims tokenClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return a.key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(a.issuer))
+	if err != nil {
+		return nil, err
+	}
+	if claims.ExpiresAt == nil {
+		return nil, errors.New("token has no expiry")
+	}
+	id, err := strconv.ParseInt(claims.Subject, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token subject: %w", err)
+	}
+	return &principal{UserID: int32(id), Role: claims.Role}, nil
+}
+
+// unaryInterceptor rejects calls without a valid access token and stores the
+// caller in the context of those it lets through.
+func (a *authenticator) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if publicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing access token")
+	}
+	scheme, token, _ := strings.Cut(values[0], " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return nil, status.Error(codes.Unauthenticated, "authorization must be a Bearer token")
+	}
+	p, err := a.verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	return handler(withPrincipal(ctx, p), req)
+}
+
+func (s *server) Authenticate(ctx context.Context, req *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error) {
+	fmt.Println("Authenticate", req.GetLogin())
+	errLogin := status.Error(codes.Unauthenticated, "invalid login or password")
+	user, err := s.users.GetUserByLogin(ctx, req.GetLogin())
+	if errors.Is(err, ErrNotFound) {
+		return nil, errLogin
+	}
+	if err != nil {
+		return nil, storeError(err, "user", "")
+	}
+	ok, err := s.checkPassword(ctx, user, req.GetPassword())
+	if err != nil {
+		log.Printf("Upgrading password of user %d: %v", user.UserID, err)
+	}
+	if !ok {
+		return nil, errLogin
+	}
+
+	token, err := s.auth.issue(user)
+	if err != nil {
+		log.Printf("Issuing a token for user %d: %v", user.UserID, err)
+		return nil, status.Error(codes.Internal, "authentication unsuccessful")
+	}
+	return &pb.AuthenticateResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(s.auth.ttl.Seconds()),
+		User:        userToProto(user),
+	}, nil
+}
+
+// ensureAdmin creates the configured admin user unless a user with its email
+// already exists.
+func ensureAdmin(ctx context.Context, users UserStore, cfg AuthConfig) error {
+	if cfg.AdminEmail == "" {
+		return nil
+	}
+	_, err := users.GetUserByLogin(ctx, cfg.AdminEmail)
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	hash, err := hashPassword(cfg.AdminPassword)
+	if err != nil {
+		return err
+	}
+	return users.CreateUser(ctx, &User{Email: cfg.AdminEmail, Password: hash, AccountType: roleAdmin})
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthenticate(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	created, err := ts.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{Email: "juan@example.com", Password: "correct horse", AccountType: "Faculty"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ts.Authenticate(ctx, &pb.AuthenticateRequest{Login: "juan@example.com", Password: "battery staple"})
+	wantCode(t, err, codes.Unauthenticated)
+	_, err = ts.Authenticate(ctx, &pb.AuthenticateRequest{Login: "maria@example.com", Password: "correct horse"})
+	wantCode(t, err, codes.Unauthenticated)
+
+	res, err := ts.Authenticate(ctx, &pb.AuthenticateRequest{Login: "juan@example.com", Password: "correct horse"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got *principal
+	handler := func(ctx context.Context, req any) (any, error) {
+		got, _ = principalFrom(ctx)
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.RMSService/GetAuthors"}
+	withToken := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+res.GetAccessToken()))
+	if _, err := ts.auth.unaryInterceptor(withToken, nil, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || got.UserID != created.GetUser().GetUserId() || got.Role != "Faculty" {
+		t.Fatalf("the call was made as %+v", got)
+	}
+
+	for name, ctx := range map[string]context.Context{
+		"no token":      ctx,
+		"invalid token": metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+res.GetAccessToken()+"x")),
+		"basic":         metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Basic anVhbjpob3JzZQ==")),
+	} {
+		_, err := ts.auth.unaryInterceptor(ctx, nil, info, handler)
+		if code := status.Code(err); code != codes.Unauthenticated {
+			t.Errorf("%s: got %v, want Unauthenticated", name, code)
+		}
+	}
+	public := &grpc.UnaryServerInfo{FullMethod: "/proto.RMSService/Authenticate"}
+	if _, err := ts.auth.unaryInterceptor(ctx, nil, public, handler); err != nil {
+		t.Errorf("Authenticate needs a token: %v", err)
+	}
+}
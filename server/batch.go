@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// Batch RPCs run each of their requests through the interceptors and handler
+// of the single-record RPC, so it is authorized, validated, logged and
+// revised exactly like a call of its own.
+
+const maxBatchSize = 500
+
+// interceptors are the interceptors every call runs through once it is
+// authenticated, batched requests included.
+func (s *server) interceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{s.authorize, s.validate, s.audit, s.revise}
+}
+
+// chainUnary wraps handler in interceptors, the first outermost.
+func chainUnary(interceptors []grpc.UnaryServerInterceptor, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+	return handler
+}
+
+// call runs handler as the RPC method, after the interceptors of a call of
+// its own.
+func (s *server) call(ctx context.Context, method string, req any, handler grpc.UnaryHandler) (any, error) {
+	info := &grpc.UnaryServerInfo{Server: s, FullMethod: "/proto.RMSService/" + method}
+	return chainUnary(s.interceptors(), info, handler)(ctx, req)
+}
+
+// runBatch calls handler, as the RPC fullMethod, with every request of a
+// batch. In all-or-nothing mode the calls share one transaction and the
+// first to fail fails the batch; otherwise the error of each call is
+// returned at its index.
+func runBatch[Req, Res any](ctx context.Context, s *server, fullMethod string, mode pb.BatchMode, reqs []Req, handler func(context.Context, Req) (Res, error)) ([]Res, []error, error) {
+	call := chainUnary(s.interceptors(), &grpc.UnaryServerInfo{Server: s, FullMethod: fullMethod}, func(ctx context.Context, req any) (any, error) {
+		return handler(ctx, req.(Req))
+	})
+	results := make([]Res, len(reqs))
+	errs := make([]error, len(reqs))
+	run := func(ctx context.Context, i int) error {
+		res, err := call(ctx, reqs[i])
+		if err != nil {
+			return err
+		}
+		results[i] = res.(Res)
+		return nil
+	}
+
+	if mode == pb.BatchMode_BATCH_MODE_BEST_EFFORT {
+		for i := range reqs {
+			errs[i] = run(ctx, i)
+		}
+		return results, errs, nil
+	}
+	err := s.tx.Transaction(ctx, func(ctx context.Context) error {
+		for i := range reqs {
+			if err := run(ctx, i); err != nil {
+				return batchItemError(i, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, errs, nil
+}
+
+// batchItemError names the request of a batch that failed in its error
+// message and in the fields of its violations.
+func batchItemError(i int, err error) error {
+	st := status.Convert(err)
+	prefix := fmt.Sprintf("requests[%d]", i)
+	var details []protoiface.MessageV1
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			prefixed := &errdetails.BadRequest{}
+			for _, v := range br.GetFieldViolations() {
+				prefixed.FieldViolations = append(prefixed.FieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       prefix + "." + v.GetField(),
+					Description: v.GetDescription(),
+				})
+			}
+			d = prefixed
+		}
+		if m, ok := d.(protoiface.MessageV1); ok {
+			details = append(details, m)
+		}
+	}
+	return withDetails(status.New(st.Code(), prefix+": "+st.Message()), details...)
+}
+
+// batchStatus is the status of a request of a batch, OK when err is nil.
+func batchStatus(err error) *spb.Status {
+	return status.Convert(err).Proto()
+}
+
+func (s *server) BatchCreateAuthors(ctx context.Context, req *pb.BatchCreateAuthorsRequest) (*pb.BatchCreateAuthorsResponse, error) {
+	fmt.Println("Batch Create Authors")
+	results, errs, err := runBatch(ctx, s, "/proto.RMSService/CreateAuthor", req.GetMode(), req.GetRequests(), s.CreateAuthor)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BatchCreateAuthorsResponse{Results: []*pb.AuthorResult{}}
+	for i := range results {
+		res.Results = append(res.Results, &pb.AuthorResult{Author: results[i].GetAuthor(), Status: batchStatus(errs[i])})
+	}
+	return res, nil
+}
+
+func (s *server) BatchUpdateAuthors(ctx context.Context, req *pb.BatchUpdateAuthorsRequest) (*pb.BatchUpdateAuthorsResponse, error) {
+	fmt.Println("Batch Update Authors")
+	results, errs, err := runBatch(ctx, s, "/proto.RMSService/UpdateAuthor", req.GetMode(), req.GetRequests(), s.UpdateAuthor)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BatchUpdateAuthorsResponse{Results: []*pb.AuthorResult{}}
+	for i := range results {
+		res.Results = append(res.Results, &pb.AuthorResult{Author: results[i].GetAuthor(), Status: batchStatus(errs[i])})
+	}
+	return res, nil
+}
+
+func (s *server) BatchCreateIP_Assets(ctx context.Context, req *pb.BatchCreateIP_AssetsRequest) (*pb.BatchCreateIP_AssetsResponse, error) {
+	fmt.Println("Batch Create IP Assets")
+	results, errs, err := runBatch(ctx, s, "/proto.RMSService/CreateIP_Asset", req.GetMode(), req.GetRequests(), s.CreateIP_Asset)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BatchCreateIP_AssetsResponse{Results: []*pb.IP_AssetResult{}}
+	for i := range results {
+		res.Results = append(res.Results, &pb.IP_AssetResult{IpAsset: results[i].GetIpAsset(), Status: batchStatus(errs[i])})
+	}
+	return res, nil
+}
+
+func (s *server) BatchUpdateIP_Assets(ctx context.Context, req *pb.BatchUpdateIP_AssetsRequest) (*pb.BatchUpdateIP_AssetsResponse, error) {
+	fmt.Println("Batch Update IP Assets")
+	results, errs, err := runBatch(ctx, s, "/proto.RMSService/UpdateIP_Asset", req.GetMode(), req.GetRequests(), s.UpdateIP_Asset)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BatchUpdateIP_AssetsResponse{Results: []*pb.IP_AssetResult{}}
+	for i := range results {
+		res.Results = append(res.Results, &pb.IP_AssetResult{IpAsset: results[i].GetIpAsset(), Status: batchStatus(errs[i])})
+	}
+	return res, nil
+}
+
+func (s *server) BatchCreatePublications(ctx context.Context, req *pb.BatchCreatePublicationsRequest) (*pb.BatchCreatePublicationsResponse, error) {
+	fmt.Println("Batch Create Publications")
+	results, errs, err := runBatch(ctx, s, "/proto.RMSService/CreatePublication", req.GetMode(), req.GetRequests(), s.CreatePublication)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BatchCreatePublicationsResponse{Results: []*pb.PublicationResult{}}
+	for i := range results {
+		res.Results = append(res.Results, &pb.PublicationResult{Publication: results[i].GetPublication(), Status: batchStatus(errs[i])})
+	}
+	return res, nil
+}
+
+func (s *server) BatchUpdatePublications(ctx context.Context, req *pb.BatchUpdatePublicationsRequest) (*pb.BatchUpdatePublicationsResponse, error) {
+	fmt.Println("Batch Update Publications")
+	results, errs, err := runBatch(ctx, s, "/proto.RMSService/UpdatePublication", req.GetMode(), req.GetRequests(), s.UpdatePublication)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BatchUpdatePublicationsResponse{Results: []*pb.PublicationResult{}}
+	for i := range results {
+		res.Results = append(res.Results, &pb.PublicationResult{Publication: results[i].GetPublication(), Status: batchStatus(errs[i])})
+	}
+	return res, nil
+}
+
+func (s *server) BatchCreateUsers(ctx context.Context, req *pb.BatchCreateUsersRequest) (*pb.BatchCreateUsersResponse, error) {
+	fmt.Println("Batch Create Users")
+	results, errs, err := runBatch(ctx, s, "/proto.RMSService/CreateUser", req.GetMode(), req.GetRequests(), s.CreateUser)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BatchCreateUsersResponse{Results: []*pb.UserResult{}}
+	for i := range results {
+		res.Results = append(res.Results, &pb.UserResult{User: results[i].GetUser(), Status: batchStatus(errs[i])})
+	}
+	return res, nil
+}
+
+func (s *server) BatchUpdateUsers(ctx context.Context, req *pb.BatchUpdateUsersRequest) (*pb.BatchUpdateUsersResponse, error) {
+	fmt.Println("Batch Update Users")
+	results, errs, err := runBatch(ctx, s, "/proto.RMSService/UpdateUser", req.GetMode(), req.GetRequests(), s.UpdateUser)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BatchUpdateUsersResponse{Results: []*pb.UserResult{}}
+	for i := range results {
+		res.Results = append(res.Results, &pb.UserResult{User: results[i].GetUser(), Status: batchStatus(errs[i])})
+	}
+	return res, nil
+}
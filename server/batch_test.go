@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func authorRequests(names ...string) []*pb.CreateAuthorRequest {
+	var reqs []*pb.CreateAuthorRequest
+	for _, name := range names {
+		reqs = append(reqs, &pb.CreateAuthorRequest{Author: &pb.Author{AuthorName: name}})
+	}
+	return reqs
+}
+
+func countAuthors(t *testing.T, ts *testServer) int64 {
+	t.Helper()
+	n, err := ts.store.CountAuthors(context.Background(), ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestBatchAllOrNothing(t *testing.T) {
+	ts := newTestServer(t)
+	_, err := call(ts.as(roleAdmin), ts, "BatchCreateAuthors", (*server).BatchCreateAuthors, &pb.BatchCreateAuthorsRequest{
+		Requests: authorRequests("Juan Dela Cruz", "", "Maria Santos"),
+		Mode:     pb.BatchMode_BATCH_MODE_ALL_OR_NOTHING,
+	})
+	wantCode(t, err, codes.InvalidArgument)
+	if !strings.HasPrefix(status.Convert(err).Message(), "requests[1]: ") {
+		t.Errorf("error %q does not name the failed request", status.Convert(err).Message())
+	}
+	if n := countAuthors(t, ts); n != 0 {
+		t.Errorf("a failed all-or-nothing batch created %d authors", n)
+	}
+	logs, err := ts.store.CountLogs(context.Background(), ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if logs != 0 {
+		t.Errorf("a failed all-or-nothing batch logged %d entries", logs)
+	}
+}
+
+func TestBatchBestEffort(t *testing.T) {
+	ts := newTestServer(t)
+	res, err := call(ts.as(roleAdmin), ts, "BatchCreateAuthors", (*server).BatchCreateAuthors, &pb.BatchCreateAuthorsRequest{
+		Requests: authorRequests("Juan Dela Cruz", "", "Maria Santos"),
+		Mode:     pb.BatchMode_BATCH_MODE_BEST_EFFORT,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []codes.Code
+	for _, r := range res.GetResults() {
+		got = append(got, status.FromProto(r.GetStatus()).Code())
+	}
+	if fmt.Sprint(got) != fmt.Sprint([]codes.Code{codes.OK, codes.InvalidArgument, codes.OK}) {
+		t.Fatalf("result codes %v, want [OK InvalidArgument OK]", got)
+	}
+	if res.GetResults()[2].GetAuthor().GetAuthorName() != "Maria Santos" {
+		t.Errorf("result %v", res.GetResults()[2])
+	}
+	if n := countAuthors(t, ts); n != 2 {
+		t.Errorf("created %d authors, want 2", n)
+	}
+}
+
+func TestBatchAuthorizesEachRequest(t *testing.T) {
+	ts := newTestServer(t)
+	created, err := call(ts.as(roleAdmin), ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := call(ts.as(roleFaculty), ts, "BatchUpdatePublications", (*server).BatchUpdatePublications, &pb.BatchUpdatePublicationsRequest{
+		Requests: []*pb.UpdatePublicationRequest{{Publication: &pb.Publication{PublicationId: created.GetPublication().GetPublicationId(), Abstract: "..."}}},
+		Mode:     pb.BatchMode_BATCH_MODE_BEST_EFFORT,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := status.FromProto(res.GetResults()[0].GetStatus()).Code(); code != codes.PermissionDenied {
+		t.Fatalf("faculty updating an unlinked publication got %v, want PermissionDenied", code)
+	}
+}
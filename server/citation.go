@@ -0,0 +1,351 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+	"unicode"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+// Publications are exported as citations for reference managers in BibTeX,
+// RIS and CSL-JSON. Authors is free text, so the names in it are parsed as
+// the lists people usually write: "Dela Cruz, Juan and Santos, Maria",
+// "Juan Dela Cruz; Maria Santos", "J. Dela Cruz, M. Santos & A. Reyes" or
+// "Dela Cruz J, Santos M".
+
+// citationType is the entry type of a kind of publication in each format,
+// and the field of each that names its publisher: a journal is cited by
+// name, and theses and reports by the school or institution behind them.
+type citationType struct {
+	keyword            string
+	bibtex, ris, csl   string
+	bibtexPublisher    string
+	risPublisher       string
+	cslContainerTitled bool
+	// label is the TypeOfPublication given to imported entries of the type
+	// when no term of its vocabulary names the keyword.
+	label string
+}
+
+// citationTypes are matched in order against TypeOfPublication, ignoring
+// case; the first whose keyword it contains gives the entry type.
+var citationTypes = []citationType{
+	{"journal", "article", "JOUR", "article-journal", "journal", "T2", true, "Journal Article"},
+	{"conference", "inproceedings", "CPAPER", "paper-conference", "publisher", "PB", false, "Conference Paper"},
+	{"proceeding", "inproceedings", "CPAPER", "paper-conference", "publisher", "PB", false, "Conference Paper"},
+	{"chapter", "incollection", "CHAP", "chapter", "publisher", "PB", false, "Book Chapter"},
+	{"book", "book", "BOOK", "book", "publisher", "PB", false, "Book"},
+	{"dissertation", "phdthesis", "THES", "thesis", "school", "PB", false, "Dissertation"},
+	{"thesis", "mastersthesis", "THES", "thesis", "school", "PB", false, "Thesis"},
+	{"report", "techreport", "RPRT", "report", "institution", "PB", false, "Technical Report"},
+}
+
+var otherCitationType = citationType{"", "misc", "GEN", "document", "publisher", "PB", false, ""}
+
+func citationTypeOf(typeOfPublication string) citationType {
+	t := strings.ToLower(typeOfPublication)
+	for _, ct := range citationTypes {
+		if strings.Contains(t, ct.keyword) {
+			return ct
+		}
+	}
+	return otherCitationType
+}
+
+// personName is an author's name split into family and given names.
+type personName struct {
+	Family string
+	Given  string
+}
+
+// String formats a name as "Family, Given", as BibTeX and RIS both take it.
+func (n personName) String() string {
+	if n.Given == "" {
+		return n.Family
+	}
+	return n.Family + ", " + n.Given
+}
+
+var (
+	authorAnd      = regexp.MustCompile(`(?i),?\s+(?:and|&)\s+`)
+	authorInitials = regexp.MustCompile(`^(?:[A-Z]\.?){1,3}$`)
+)
+
+// nameParticles start family names, as in "Juan dela Cruz" or "Ana de los
+// Santos", when they come after the first word of a name.
+var nameParticles = map[string]bool{
+	"da": true, "das": true, "de": true, "del": true, "dela": true, "della": true, "delos": true, "den": true,
+	"der": true, "di": true, "dos": true, "du": true, "la": true, "las": true, "le": true, "los": true,
+	"san": true, "santa": true, "sta.": true, "van": true, "von": true,
+}
+
+// parseAuthors splits a list of authors into their names, dropping "et al.".
+func parseAuthors(authors string) []personName {
+	var names []personName
+	for _, part := range authorAnd.Split(strings.ReplaceAll(authors, ";", " and "), -1) {
+		pieces := strings.Split(part, ",")
+		// A single comma between a one-word part and the rest is the one
+		// in "Cruz, Juan Miguel" or "Dela Cruz, Juan", not a list.
+		if len(pieces) == 2 && (len(strings.Fields(pieces[0])) == 1 || len(strings.Fields(pieces[1])) == 1) {
+			pieces = []string{part}
+		}
+		for _, piece := range pieces {
+			if name, ok := parseName(piece); ok {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// parseName splits a name written as "Family, Given", "Family Initials" or
+// "Given Family".
+func parseName(name string) (personName, bool) {
+	name = strings.Join(strings.Fields(name), " ")
+	switch strings.ToLower(strings.TrimSuffix(name, ".")) {
+	case "", "et al", "others":
+		return personName{}, false
+	}
+	if family, given, ok := strings.Cut(name, ","); ok {
+		return personName{Family: strings.TrimSpace(family), Given: strings.TrimSpace(given)}, true
+	}
+	words := strings.Fields(name)
+	last := len(words) - 1
+	if last == 0 {
+		return personName{Family: name}, true
+	}
+	if authorInitials.MatchString(words[last]) && !authorInitials.MatchString(words[0]) {
+		return personName{Family: strings.Join(words[:last], " "), Given: words[last]}, true
+	}
+	family := last
+	for family > 1 && nameParticles[strings.ToLower(words[family-1])] {
+		family--
+	}
+	return personName{Family: strings.Join(words[family:], " "), Given: strings.Join(words[:family], " ")}, true
+}
+
+// keyWord lowercases s and strips it to ASCII letters and digits, dropping
+// accents, for use in citation keys.
+func keyWord(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+var keyStopWords = map[string]bool{"a": true, "an": true, "the": true, "on": true, "of": true, "in": true, "and": true, "for": true, "to": true}
+
+// citationKey is the key of a publication in every export, e.g.
+// "delacruz2021deep_3f2a": the first author's family name, the year, the
+// first word of the title, and a hash of the publication ID that tells apart
+// publications alike in the rest.
+func citationKey(p *pb.Publication) string {
+	author := "anon"
+	if names := parseAuthors(p.GetAuthors()); len(names) > 0 {
+		if w := keyWord(names[0].Family); w != "" {
+			author = w
+		}
+	}
+	year := "nd"
+	if y := p.GetDatePublished().GetYear(); y != 0 {
+		year = fmt.Sprint(y)
+	}
+	word := ""
+	for _, w := range strings.Fields(p.GetTitleOfPaper()) {
+		if w = keyWord(w); w != "" && !keyStopWords[w] {
+			word = w
+			break
+		}
+	}
+	h := fnv.New32a()
+	h.Write([]byte(p.GetPublicationId()))
+	return fmt.Sprintf("%s%s%s_%04x", author, year, word, h.Sum32()&0xffff)
+}
+
+// citationDateParts returns the year, month and day of a publication date as
+// far as they are known.
+func citationDateParts(d *date.Date) []int32 {
+	switch datePrecision(d) {
+	case "":
+		return nil
+	case precisionYear:
+		return []int32{d.GetYear()}
+	case precisionMonth:
+		return []int32{d.GetYear(), d.GetMonth()}
+	}
+	return []int32{d.GetYear(), d.GetMonth(), d.GetDay()}
+}
+
+var bibtexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`, "{", `\{`, "}", `\}`, "&", `\&`, "%", `\%`, "$", `\$`, "#", `\#`, "_", `\_`,
+	"~", `\textasciitilde{}`, "^", `\textasciicircum{}`,
+)
+
+var bibtexMonths = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+func writeBibTeX(buf *bytes.Buffer, publications []*pb.Publication) {
+	for i, p := range publications {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+		ct := citationTypeOf(p.GetTypeOfPublication())
+		fmt.Fprintf(buf, "@%s{%s,\n", ct.bibtex, citationKey(p))
+		field := func(name, value string) {
+			if value != "" {
+				fmt.Fprintf(buf, "  %s = {%s},\n", name, bibtexEscaper.Replace(value))
+			}
+		}
+		var authors []string
+		for _, n := range parseAuthors(p.GetAuthors()) {
+			authors = append(authors, n.String())
+		}
+		field("author", strings.Join(authors, " and "))
+		field("title", p.GetTitleOfPaper())
+		field(ct.bibtexPublisher, p.GetPublisher())
+		parts := citationDateParts(p.GetDatePublished())
+		if len(parts) > 0 {
+			fmt.Fprintf(buf, "  year = %d,\n", parts[0])
+		}
+		if len(parts) > 1 && parts[1] >= 1 && parts[1] <= 12 {
+			fmt.Fprintf(buf, "  month = %s,\n", bibtexMonths[parts[1]-1])
+		}
+		buf.WriteString("}\n")
+	}
+}
+
+func writeRIS(buf *bytes.Buffer, publications []*pb.Publication) {
+	for _, p := range publications {
+		ct := citationTypeOf(p.GetTypeOfPublication())
+		tag := func(name, value string) {
+			if value = strings.Join(strings.Fields(value), " "); value != "" {
+				fmt.Fprintf(buf, "%s  - %s\r\n", name, value)
+			}
+		}
+		tag("TY", ct.ris)
+		tag("ID", citationKey(p))
+		for _, n := range parseAuthors(p.GetAuthors()) {
+			tag("AU", n.String())
+		}
+		tag("TI", p.GetTitleOfPaper())
+		tag(ct.risPublisher, p.GetPublisher())
+		if parts := citationDateParts(p.GetDatePublished()); len(parts) > 0 {
+			tag("PY", fmt.Sprintf("%04d", parts[0]))
+			da := fmt.Sprintf("%04d", parts[0])
+			for _, part := range parts[1:] {
+				da += fmt.Sprintf("/%02d", part)
+			}
+			tag("DA", da+strings.Repeat("/", 4-len(parts)))
+		}
+		buf.WriteString("ER  - \r\n\r\n")
+	}
+}
+
+type cslName struct {
+	Family string `json:"family"`
+	Given  string `json:"given,omitempty"`
+}
+
+type cslDate struct {
+	DateParts [][]int32 `json:"date-parts"`
+}
+
+type cslItem struct {
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	Title          string    `json:"title,omitempty"`
+	Author         []cslName `json:"author,omitempty"`
+	Issued         *cslDate  `json:"issued,omitempty"`
+	ContainerTitle string    `json:"container-title,omitempty"`
+	Publisher      string    `json:"publisher,omitempty"`
+}
+
+func writeCSLJSON(buf *bytes.Buffer, publications []*pb.Publication) error {
+	items := []cslItem{}
+	for _, p := range publications {
+		ct := citationTypeOf(p.GetTypeOfPublication())
+		item := cslItem{ID: citationKey(p), Type: ct.csl, Title: p.GetTitleOfPaper()}
+		for _, n := range parseAuthors(p.GetAuthors()) {
+			item.Author = append(item.Author, cslName(n))
+		}
+		if parts := citationDateParts(p.GetDatePublished()); len(parts) > 0 {
+			item.Issued = &cslDate{DateParts: [][]int32{parts}}
+		}
+		if ct.cslContainerTitled {
+			item.ContainerTitle = p.GetPublisher()
+		} else {
+			item.Publisher = p.GetPublisher()
+		}
+		items = append(items, item)
+	}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(items)
+}
+
+// citedPublications reads the publications to cite, through GetPublication or
+// GetPublications so that they are authorized like any other read.
+func (s *server) citedPublications(ctx context.Context, req *pb.ExportCitationsRequest) ([]*pb.Publication, error) {
+	if len(req.GetPublicationIds()) > 0 {
+		var publications []*pb.Publication
+		for _, id := range req.GetPublicationIds() {
+			res, err := s.call(ctx, "GetPublication", &pb.ReadPublicationRequest{PublicationId: id},
+				func(ctx context.Context, req any) (any, error) {
+					return s.GetPublication(ctx, req.(*pb.ReadPublicationRequest))
+				})
+			if err != nil {
+				return nil, err
+			}
+			publications = append(publications, res.(*pb.ReadPublicationResponse).GetPublication())
+		}
+		return publications, nil
+	}
+
+	res, err := s.call(ctx, "GetPublications", &pb.ReadPublicationsRequest{PageSize: maxPageSize, Filter: req.GetFilter(), OrderBy: req.GetOrderBy()},
+		func(ctx context.Context, req any) (any, error) {
+			return s.GetPublications(ctx, req.(*pb.ReadPublicationsRequest))
+		})
+	if err != nil {
+		return nil, err
+	}
+	if res.(*pb.ReadPublicationsResponse).GetNextPageToken() != "" {
+		return nil, invalidArgument("filter", fmt.Sprintf("matches more than %d publications", maxPageSize))
+	}
+	return res.(*pb.ReadPublicationsResponse).GetPublications(), nil
+}
+
+func (s *server) ExportCitations(ctx context.Context, req *pb.ExportCitationsRequest) (*pb.ExportCitationsResponse, error) {
+	fmt.Println("Export Citations", req.GetFormat())
+	publications, err := s.citedPublications(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	switch req.GetFormat() {
+	case "bibtex":
+		writeBibTeX(&buf, publications)
+	case "ris":
+		writeRIS(&buf, publications)
+	case "csl-json":
+		if err := writeCSLJSON(&buf, publications); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ExportCitationsResponse{
+		Citations: buf.String(),
+		Count:     int32(len(publications)),
+	}, nil
+}
@@ -0,0 +1,760 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BibTeX and RIS files are imported as draft publications for staff to
+// review. Entries are read leniently, one at a time, so that an entry that
+// cannot be read is reported and skipped without failing the rest. Each entry
+// is created in a transaction of its own within that of the import, so that
+// one that fails rolls back only its own writes.
+
+// citationEntry is an entry of an imported BibTeX or RIS file, read into the
+// fields of a publication. err is set when the entry could not be read.
+type citationEntry struct {
+	line      int
+	key       string
+	types     []citationType
+	title     string
+	authors   []personName
+	publisher string
+	abstract  string
+	date      *date.Date
+	err       error
+}
+
+// publication is the draft publication of an entry.
+func (e *citationEntry) publication(typeOfPublication string) *pb.Publication {
+	var authors []string
+	for _, n := range e.authors {
+		authors = append(authors, n.String())
+	}
+	return &pb.Publication{
+		TitleOfPaper:      e.title,
+		Authors:           strings.Join(authors, "; "),
+		Publisher:         e.publisher,
+		Abstract:          e.abstract,
+		DatePublished:     e.date,
+		TypeOfPublication: typeOfPublication,
+		Draft:             true,
+	}
+}
+
+// citationTypesWhere returns the citation types f accepts.
+func citationTypesWhere(f func(citationType) bool) []citationType {
+	var types []citationType
+	for _, ct := range citationTypes {
+		if f(ct) {
+			types = append(types, ct)
+		}
+	}
+	return types
+}
+
+// importedTypeOfPublication is the TypeOfPublication of an entry of one of
+// types: the first term of the type_of_publication vocabulary that names one
+// of their keywords, or else the label of the first.
+func importedTypeOfPublication(terms []string, types []citationType) string {
+	for _, ct := range types {
+		for _, t := range terms {
+			if strings.Contains(strings.ToLower(t), ct.keyword) {
+				return t
+			}
+		}
+	}
+	if len(types) > 0 {
+		return types[0].label
+	}
+	return ""
+}
+
+// titleKey is the form of a title duplicates are detected on, which ignores
+// case, accents and punctuation, and takes & for "and".
+func titleKey(title string) string {
+	var words []string
+	for _, w := range strings.Fields(strings.ReplaceAll(title, "&", " and ")) {
+		if w = keyWord(w); w != "" {
+			words = append(words, w)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// parseCitationDate reads a date written as 2021-06-30, 2021/06/30/ as in
+// RIS, or a prefix of either, leaving the parts it lacks zero.
+func parseCitationDate(s string) (*date.Date, bool) {
+	parts := strings.FieldsFunc(strings.TrimSpace(s), func(r rune) bool { return r == '-' || r == '/' })
+	if len(parts) == 0 || len(parts[0]) != 4 {
+		return nil, false
+	}
+	d := &date.Date{}
+	for i, field := range []*int32{&d.Year, &d.Month, &d.Day} {
+		if i >= len(parts) {
+			break
+		}
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			break
+		}
+		*field = int32(n)
+	}
+	if d.Year == 0 {
+		return nil, false
+	}
+	return d, true
+}
+
+// parseMonth reads a month written as a number or an English name.
+func parseMonth(s string) int32 {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if n, err := strconv.Atoi(s); err == nil && n >= 1 && n <= 12 {
+		return int32(n)
+	}
+	for i, m := range bibtexMonths {
+		if len(s) >= 3 && strings.HasPrefix(s, m) {
+			return int32(i + 1)
+		}
+	}
+	return 0
+}
+
+var bibtexTypeAliases = map[string]string{"conference": "inproceedings", "inbook": "incollection", "thesis": "mastersthesis"}
+
+// bibtexMacros are the strings BibTeX predefines.
+var bibtexMacros = map[string]string{
+	"jan": "January", "feb": "February", "mar": "March", "apr": "April", "may": "May", "jun": "June",
+	"jul": "July", "aug": "August", "sep": "September", "oct": "October", "nov": "November", "dec": "December",
+}
+
+// bibtexParser reads the entries of a BibTeX file.
+type bibtexParser struct {
+	s       string
+	pos     int
+	line    int
+	linePos int
+	macros  map[string]string
+}
+
+// bibtexEntry is an entry as written in a BibTeX file, with its field values
+// still in TeX.
+type bibtexEntry struct {
+	line   int
+	kind   string
+	key    string
+	fields map[string]string
+	err    error
+}
+
+// lineAt returns the line of pos, which must not be before the last pos it
+// was called with.
+func (p *bibtexParser) lineAt(pos int) int {
+	p.line += strings.Count(p.s[p.linePos:pos], "\n")
+	p.linePos = pos
+	return p.line
+}
+
+func (p *bibtexParser) skipSpace() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+// peek returns the next byte after any space, or 0 at the end of the file.
+func (p *bibtexParser) peek() byte {
+	p.skipSpace()
+	if p.pos == len(p.s) {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *bibtexParser) ident() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && !strings.ContainsRune(" \t\r\n{}(),=#\"%", rune(p.s[p.pos])) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// balanced reads the text between the delimiter at pos and the close that
+// matches it, counting braces.
+func (p *bibtexParser) balanced(close byte) (string, error) {
+	start, depth := p.pos+1, 0
+	for p.pos++; p.pos < len(p.s); p.pos++ {
+		switch c := p.s[p.pos]; {
+		case c == close && depth == 0:
+			p.pos++
+			return p.s[start : p.pos-1], nil
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+			if depth < 0 {
+				return "", errors.New("unbalanced braces")
+			}
+		}
+	}
+	return "", errors.New("unbalanced braces")
+}
+
+// value reads a field value: braced or quoted text, a number or a string
+// name, joined by #.
+func (p *bibtexParser) value() (string, error) {
+	var b strings.Builder
+	for {
+		switch c := p.peek(); {
+		case c == '{':
+			s, err := p.balanced('}')
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(s)
+		case c == '"':
+			s, err := p.balanced('"')
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(s)
+		case c >= '0' && c <= '9':
+			b.WriteString(p.ident())
+		default:
+			name := p.ident()
+			if name == "" {
+				return "", errors.New("expected a value")
+			}
+			s, ok := p.macros[strings.ToLower(name)]
+			if !ok {
+				return "", fmt.Errorf("undefined string %q", name)
+			}
+			b.WriteString(s)
+		}
+		if p.peek() != '#' {
+			return b.String(), nil
+		}
+		p.pos++
+	}
+}
+
+func (p *bibtexParser) expect(c byte, after string) error {
+	if p.peek() != c {
+		return fmt.Errorf("expected %q after %s", c, after)
+	}
+	p.pos++
+	return nil
+}
+
+// body reads an entry from its opening delimiter on, which close closes.
+func (p *bibtexParser) body(e *bibtexEntry, close byte) error {
+	switch e.kind {
+	case "comment":
+		_, err := p.balanced(close)
+		return err
+	case "preamble":
+		p.pos++
+		if _, err := p.value(); err != nil {
+			return err
+		}
+		return p.expect(close, "@preamble")
+	case "string":
+		p.pos++
+		name := p.ident()
+		if err := p.expect('=', "@string name"); err != nil {
+			return err
+		}
+		value, err := p.value()
+		if err != nil {
+			return err
+		}
+		p.macros[strings.ToLower(name)] = value
+		return p.expect(close, "@string value")
+	}
+
+	p.pos++
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] != ',' && p.s[p.pos] != close {
+		p.pos++
+	}
+	e.key = strings.TrimSpace(p.s[start:p.pos])
+	e.fields = map[string]string{}
+	for {
+		switch p.peek() {
+		case close:
+			p.pos++
+			return nil
+		case ',':
+			p.pos++
+			continue
+		case 0:
+			return fmt.Errorf("@%s{%s has no closing %q", e.kind, e.key, close)
+		}
+		name := strings.ToLower(p.ident())
+		if name == "" {
+			return fmt.Errorf("expected a field name in @%s{%s", e.kind, e.key)
+		}
+		if err := p.expect('=', "field "+name); err != nil {
+			return err
+		}
+		value, err := p.value()
+		if err != nil {
+			return fmt.Errorf("field %s: %w", name, err)
+		}
+		e.fields[name] = value
+		if c := p.peek(); c != ',' && c != close {
+			return fmt.Errorf("expected , or %q after field %s", close, name)
+		}
+	}
+}
+
+// recover skips to the next line that starts with an @.
+func (p *bibtexParser) recover() {
+	for {
+		i := strings.IndexByte(p.s[p.pos:], '\n')
+		if i < 0 {
+			p.pos = len(p.s)
+			return
+		}
+		p.pos += i + 1
+		rest := strings.TrimLeft(p.s[p.pos:], " \t")
+		if strings.HasPrefix(rest, "@") {
+			p.pos = len(p.s) - len(rest)
+			return
+		}
+	}
+}
+
+// readBibTeX reads the entries of a BibTeX file. Text outside entries is a
+// comment, as are @comment entries, and @preamble entries are ignored.
+func readBibTeX(data []byte) []citationEntry {
+	p := &bibtexParser{s: strings.TrimPrefix(string(data), "\ufeff"), line: 1, macros: map[string]string{}}
+	for k, v := range bibtexMacros {
+		p.macros[k] = v
+	}
+	var entries []citationEntry
+	for {
+		i := strings.IndexByte(p.s[p.pos:], '@')
+		if i < 0 {
+			return entries
+		}
+		start := p.pos + i
+		p.pos = start + 1
+		e := bibtexEntry{line: p.lineAt(start), kind: strings.ToLower(p.ident())}
+		var close byte
+		switch p.peek() {
+		case '{':
+			close = '}'
+		case '(':
+			close = ')'
+		}
+		if e.kind == "" || close == 0 {
+			// An @ that opens no entry, as in an email address, is text.
+			p.pos = start + 1
+			continue
+		}
+		if e.err = p.body(&e, close); e.err != nil {
+			p.pos = start + 1
+			p.recover()
+		}
+		switch e.kind {
+		case "comment", "preamble", "string":
+			if e.err == nil {
+				continue
+			}
+		}
+		entries = append(entries, bibtexCitation(e))
+	}
+}
+
+// bibtexCitation reads the fields of a BibTeX entry.
+func bibtexCitation(e bibtexEntry) citationEntry {
+	c := citationEntry{line: e.line, key: e.key, err: e.err}
+	if e.err != nil {
+		return c
+	}
+	kind := e.kind
+	if alias, ok := bibtexTypeAliases[kind]; ok {
+		kind = alias
+	}
+	c.types = citationTypesWhere(func(ct citationType) bool { return ct.bibtex == kind })
+	c.title = texToText(e.fields["title"])
+	for _, name := range splitBibTeXNames(e.fields["author"]) {
+		if n, ok := parseBibTeXName(name); ok {
+			c.authors = append(c.authors, n)
+		}
+	}
+	publishers := []string{"publisher", "journal", "journaltitle", "school", "institution", "organization"}
+	if len(c.types) > 0 {
+		publishers = append([]string{c.types[0].bibtexPublisher}, publishers...)
+	}
+	for _, f := range publishers {
+		if v := texToText(e.fields[f]); v != "" {
+			c.publisher = v
+			break
+		}
+	}
+	c.abstract = texToText(e.fields["abstract"])
+
+	if d, ok := parseCitationDate(texToText(e.fields["date"])); ok {
+		c.date = d
+	} else if year := texToText(e.fields["year"]); year != "" {
+		d, ok := parseCitationDate(year)
+		if !ok {
+			c.err = fmt.Errorf("year %q is not a year", year)
+			return c
+		}
+		d.Month = parseMonth(texToText(e.fields["month"]))
+		c.date = d
+	}
+	return c
+}
+
+// splitBibTeXNames splits a BibTeX name list on the "and"s outside braces.
+func splitBibTeXNames(s string) []string {
+	var names []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ' ', '\t', '\r', '\n':
+			if depth == 0 && i+5 <= len(s) && strings.EqualFold(s[i+1:i+4], "and") && unicode.IsSpace(rune(s[i+4])) {
+				names = append(names, s[start:i])
+				start = i + 5
+				i += 4
+			}
+		}
+	}
+	return append(names, s[start:])
+}
+
+// parseBibTeXName parses a BibTeX name. A name wholly in braces, such as
+// {World Health Organization}, is kept as it is.
+func parseBibTeXName(name string) (personName, bool) {
+	name = strings.TrimSpace(name)
+	if strings.HasPrefix(name, "{") && strings.HasSuffix(name, "}") {
+		p := &bibtexParser{s: name}
+		if inner, err := p.balanced('}'); err == nil && p.pos == len(name) {
+			if family := texToText(inner); family != "" {
+				return personName{Family: family}, true
+			}
+			return personName{}, false
+		}
+	}
+	return parseName(texToText(name))
+}
+
+// texAccents are the combining marks of TeX accent commands.
+var texAccents = map[string]rune{
+	"'": '\u0301', "`": '\u0300', "^": '\u0302', `"`: '\u0308', "~": '\u0303', "=": '\u0304', ".": '\u0307',
+	"c": '\u0327', "v": '\u030c', "u": '\u0306', "H": '\u030b', "k": '\u0328',
+}
+
+// texLetters are the letters TeX commands stand for.
+var texLetters = map[string]string{
+	"ss": "ß", "o": "ø", "O": "Ø", "ae": "æ", "AE": "Æ", "oe": "œ", "OE": "Œ", "aa": "å", "AA": "Å",
+	"l": "ł", "L": "Ł", "i": "ı", "j": "ȷ",
+}
+
+// texToText turns the TeX of a BibTeX value into plain text: accents and
+// escaped characters become the characters they stand for, other commands
+// are dropped, and braces and extra spaces are removed.
+func texToText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '{' || c == '}':
+		case c == '~':
+			b.WriteByte(' ')
+		case c == '\\' && i+1 < len(s):
+			i++
+			c = s[i]
+			if strings.IndexByte(`&%$#_{}`, c) >= 0 {
+				b.WriteByte(c)
+				continue
+			}
+			if c == '\\' {
+				b.WriteByte(' ')
+				continue
+			}
+			name := string(c)
+			if unicode.IsLetter(rune(c)) {
+				j := i
+				for j < len(s) && unicode.IsLetter(rune(s[j])) && s[j] < unicode.MaxASCII {
+					j++
+				}
+				name, i = s[i:j], j-1
+			}
+			if mark, ok := texAccents[name]; ok {
+				arg, n := texArgument(s[i+1:])
+				i += n
+				b.WriteString(arg)
+				b.WriteRune(mark)
+				continue
+			}
+			if letter, ok := texLetters[name]; ok {
+				b.WriteString(letter)
+			}
+			if i+1 < len(s) && s[i+1] == ' ' && unicode.IsLetter(rune(c)) {
+				i++
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return norm.NFC.String(strings.Join(strings.Fields(b.String()), " "))
+}
+
+// texArgument returns the text an accent command applies to at the start of
+// s, a character or a braced group, and the bytes it takes up.
+func texArgument(s string) (string, int) {
+	trimmed := strings.TrimLeft(s, " ")
+	skipped := len(s) - len(trimmed)
+	if trimmed == "" {
+		return "", skipped
+	}
+	if trimmed[0] == '{' {
+		if end := strings.IndexByte(trimmed, '}'); end > 0 {
+			return texToText(trimmed[1:end]), skipped + end + 1
+		}
+	}
+	if trimmed[0] == '\\' && len(trimmed) > 1 && (trimmed[1] == 'i' || trimmed[1] == 'j') {
+		return trimmed[1:2], skipped + 2
+	}
+	_, size := utf8.DecodeRuneInString(trimmed)
+	return trimmed[:size], skipped + size
+}
+
+var risTag = regexp.MustCompile(`^([A-Z][A-Z0-9])\s{1,2}-\s?(.*)$`)
+
+var risTypeAliases = map[string]string{"CONF": "CPAPER", "EJOUR": "JOUR", "MGZN": "JOUR", "EBOOK": "BOOK", "EDBOOK": "BOOK", "ECHAP": "CHAP"}
+
+// risImportTypes are the citation types, by keyword, that entries of a RIS
+// type shared by several are imported as, in order of preference. A THES
+// entry is a thesis unless the vocabulary only knows dissertations, though
+// exports match "dissertation" first.
+var risImportTypes = map[string][]string{"THES": {"thesis", "dissertation"}}
+
+// readRIS reads the entries of a RIS file, each from its TY line to its ER
+// line. A line without a tag continues the value of the line before.
+func readRIS(data []byte) []citationEntry {
+	var entries []citationEntry
+	var e *citationEntry
+	var tags map[string][]string
+	var last string
+	end := func() {
+		if e != nil {
+			risCitation(e, tags)
+			entries = append(entries, *e)
+			e = nil
+		}
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	sc.Buffer(nil, maxMessageSize)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimRight(sc.Text(), " \t\r")
+		m := risTag.FindStringSubmatch(text)
+		switch {
+		case m == nil:
+			if e != nil && last != "" && strings.TrimSpace(text) != "" {
+				values := tags[last]
+				values[len(values)-1] += " " + strings.TrimSpace(text)
+			}
+		case m[1] == "TY":
+			if e != nil {
+				e.err = errors.New("has no ER line")
+				end()
+			}
+			e, tags, last = &citationEntry{line: line}, map[string][]string{}, ""
+			tags["TY"] = []string{strings.TrimSpace(m[2])}
+		case m[1] == "ER":
+			end()
+		case e != nil:
+			last = m[1]
+			tags[last] = append(tags[last], strings.TrimSpace(m[2]))
+		}
+	}
+	if e != nil {
+		e.err = errors.New("has no ER line")
+		end()
+	}
+	if err := sc.Err(); err != nil {
+		entries = append(entries, citationEntry{err: err})
+	}
+	return entries
+}
+
+// risCitation reads the tags of a RIS entry into e.
+func risCitation(e *citationEntry, tags map[string][]string) {
+	first := func(names ...string) string {
+		for _, name := range names {
+			for _, v := range tags[name] {
+				if v != "" {
+					return v
+				}
+			}
+		}
+		return ""
+	}
+	kind := first("TY")
+	if alias, ok := risTypeAliases[kind]; ok {
+		kind = alias
+	}
+	if keywords, ok := risImportTypes[kind]; ok {
+		for _, keyword := range keywords {
+			e.types = append(e.types, citationTypesWhere(func(ct citationType) bool { return ct.keyword == keyword })...)
+		}
+	} else {
+		e.types = citationTypesWhere(func(ct citationType) bool { return ct.ris == kind })
+	}
+	e.key = first("ID")
+	e.title = first("TI", "T1", "CT", "BT")
+	for _, name := range append(tags["AU"], tags["A1"]...) {
+		if n, ok := parseName(name); ok {
+			e.authors = append(e.authors, n)
+		}
+	}
+	if kind == "JOUR" {
+		e.publisher = first("T2", "JO", "JF", "JA", "J2", "PB")
+	} else {
+		e.publisher = first("PB", "T2")
+	}
+	e.abstract = first("AB", "N2")
+
+	for _, name := range []string{"DA", "PY", "Y1"} {
+		v := first(name)
+		if v == "" {
+			continue
+		}
+		d, ok := parseCitationDate(v)
+		if !ok {
+			e.err = fmt.Errorf("%s %q is not a date such as 2021/06/30/ or 2021", name, v)
+			return
+		}
+		if e.date == nil || (e.date.Month == 0 && d.Month != 0) {
+			e.date = d
+		}
+	}
+}
+
+// publicationTitles maps the title keys of every live publication to its ID,
+// read from the store rather than through GetPublications so that publications
+// the caller cannot list are not imported again.
+func (s *server) publicationTitles(ctx context.Context) (map[string]string, error) {
+	orderBy, err := parseOrderBy("", publicationQuery)
+	if err != nil {
+		return nil, err
+	}
+	opts := ListOptions{OrderBy: orderBy, Limit: maxPageSize}
+	titles := map[string]string{}
+	for {
+		publications, err := s.publications.ListPublications(ctx, opts)
+		if err != nil {
+			return nil, storeError(err, entityPublication, "")
+		}
+		for _, p := range publications {
+			if key := titleKey(p.TitleOfPaper); key != "" {
+				titles[key] = p.PublicationID
+			}
+		}
+		if len(publications) < maxPageSize {
+			return titles, nil
+		}
+		opts.After = []any{publications[len(publications)-1].PublicationID}
+	}
+}
+
+func (s *server) ImportCitations(ctx context.Context, req *pb.ImportCitationsRequest) (*pb.ImportCitationsResponse, error) {
+	fmt.Println("Import Citations", req.GetFormat())
+	var entries []citationEntry
+	switch req.GetFormat() {
+	case "bibtex":
+		entries = readBibTeX(req.GetData())
+	case "ris":
+		entries = readRIS(req.GetData())
+	}
+	titles, err := s.publicationTitles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	terms, err := s.vocabularies.ListVocabulary(ctx, "type_of_publication")
+	if err != nil {
+		return nil, storeError(err, "vocabulary", "type_of_publication")
+	}
+	var typeTerms []string
+	for _, t := range terms {
+		typeTerms = append(typeTerms, t.Value)
+	}
+
+	res := &pb.ImportCitationsResponse{Results: []*pb.ImportCitationResult{}}
+	lines := map[string]int{}
+	err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		for _, e := range entries {
+			result := &pb.ImportCitationResult{Line: int32(e.line), Key: e.key, Title: e.title, Action: actionSkip}
+			key := titleKey(e.title)
+			var err error
+			switch {
+			case e.err != nil:
+				err = status.Error(codes.InvalidArgument, e.err.Error())
+			case key == "":
+				err = status.Error(codes.InvalidArgument, "the entry has no title")
+			case lines[key] != 0:
+				result.PublicationId = titles[key]
+				err = status.Errorf(codes.AlreadyExists, "the entry on line %d has the same title", lines[key])
+			case titles[key] != "":
+				result.PublicationId = titles[key]
+				err = status.Errorf(codes.AlreadyExists, "publication %s has the same title", titles[key])
+			default:
+				var created any
+				err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+					var err error
+					created, err = s.call(ctx, "ImportCitations", &pb.CreatePublicationRequest{Publication: e.publication(importedTypeOfPublication(typeTerms, e.types))},
+						func(ctx context.Context, req any) (any, error) {
+							return s.CreatePublication(ctx, req.(*pb.CreatePublicationRequest))
+						})
+					return err
+				})
+				if err == nil {
+					result.Action = actionCreate
+					result.PublicationId = created.(*pb.CreatePublicationResponse).GetPublication().GetPublicationId()
+					titles[key] = result.PublicationId
+					lines[key] = e.line
+				}
+			}
+			result.Status = status.Convert(err).Proto()
+			if err != nil {
+				res.Skipped++
+			} else {
+				res.Created++
+			}
+			res.Results = append(res.Results, result)
+		}
+		if req.GetDryRun() {
+			return errImportRolledBack
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errImportRolledBack) {
+		return nil, storeError(err, entityPublication, "")
+	}
+	return res, nil
+}
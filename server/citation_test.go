@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCitationTypeOf(t *testing.T) {
+	tests := []struct {
+		typeOfPublication string
+		bibtex            string
+	}{
+		{"Journal Article", "article"},
+		{"Conference Proceedings", "inproceedings"},
+		{"Doctoral Dissertation", "phdthesis"},
+		{"Dissertation (PhD Thesis)", "phdthesis"},
+		{"Master's Thesis", "mastersthesis"},
+		{"Poster", "misc"},
+	}
+	for _, tt := range tests {
+		if got := citationTypeOf(tt.typeOfPublication).bibtex; got != tt.bibtex {
+			t.Errorf("citationTypeOf(%q) = %s, want %s", tt.typeOfPublication, got, tt.bibtex)
+		}
+	}
+}
+
+func TestImportedRISThesis(t *testing.T) {
+	entries := readRIS([]byte("TY  - THES\nTI  - Mangrove Carbon Stocks\nER  - \n"))
+	if len(entries) != 1 || entries[0].err != nil {
+		t.Fatalf("read %v", entries)
+	}
+	tests := []struct {
+		terms []string
+		want  string
+	}{
+		{nil, "Thesis"},
+		{[]string{"Journal Article", "Master's Thesis", "PhD Dissertation"}, "Master's Thesis"},
+		{[]string{"Journal Article", "PhD Dissertation"}, "PhD Dissertation"},
+	}
+	for _, tt := range tests {
+		if got := importedTypeOfPublication(tt.terms, entries[0].types); got != tt.want {
+			t.Errorf("with terms %q, imported as %q, want %q", tt.terms, got, tt.want)
+		}
+	}
+}
+
+func TestImportCitations(t *testing.T) {
+	ts := newTestServer(t)
+	if _, err := call(ts.as(roleAdmin), ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	data := []byte(`@article{delacruz2021,
+  title = {Deep Learning for Rice Yield},
+  author = {Dela Cruz, Juan},
+  year = 2021
+}
+@article{santos2022,
+  title = {Mangrove Carbon Stocks},
+  author = {Santos, Maria},
+  year = 2022,
+  month = mar
+}
+@article{broken,
+  author = {Santos, Maria}
+}
+`)
+	res, err := call(ts.as(roleFaculty), ts, "ImportCitations", (*server).ImportCitations, &pb.ImportCitationsRequest{Format: "bibtex", Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GetCreated() != 1 || res.GetSkipped() != 2 {
+		t.Fatalf("created %d, skipped %d: %v", res.GetCreated(), res.GetSkipped(), res.GetResults())
+	}
+	var got []codes.Code
+	for _, r := range res.GetResults() {
+		got = append(got, status.FromProto(r.GetStatus()).Code())
+	}
+	if len(got) != 3 || got[0] != codes.AlreadyExists || got[1] != codes.OK || got[2] != codes.InvalidArgument {
+		t.Errorf("result codes %v, want [AlreadyExists OK InvalidArgument]", got)
+	}
+
+	created, err := ts.store.GetPublication(context.Background(), res.GetResults()[1].GetPublicationId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !created.Draft || created.Authors != "Santos, Maria" || created.DatePublishedPrecision != precisionMonth {
+		t.Errorf("imported %+v", created)
+	}
+}
@@ -0,0 +1,329 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config is the server configuration. Values are resolved in the order
+// defaults, config file, environment variables, command-line flags, with
+// later sources overriding earlier ones.
+type Config struct {
+	Database DBConfig    `json:"database"`
+	Auth     AuthConfig  `json:"auth"`
+	Audit    AuditConfig `json:"audit"`
+	Trash    TrashConfig `json:"trash"`
+}
+
+type DBConfig struct {
+	Host         string `json:"host"`
+	Port         string `json:"port"`
+	Name         string `json:"name"`
+	User         string `json:"user"`
+	Password     string `json:"password"`
+	PasswordFile string `json:"password_file"`
+	SSLMode      string `json:"sslmode"`
+
+	MaxOpenConns    int      `json:"max_open_conns"`
+	MaxIdleConns    int      `json:"max_idle_conns"`
+	ConnMaxLifetime Duration `json:"conn_max_lifetime"`
+	ConnMaxIdleTime Duration `json:"conn_max_idle_time"`
+
+	// ConnectAttempts bounds how often the server tries to reach the
+	// database at startup; the wait between attempts starts at
+	// ConnectBackoff and doubles up to maxConnectBackoff.
+	ConnectAttempts int      `json:"connect_attempts"`
+	ConnectBackoff  Duration `json:"connect_backoff"`
+}
+
+const maxConnectBackoff = 30 * time.Second
+
+type AuthConfig struct {
+	// SigningKey is the HMAC-SHA256 key access tokens are signed with. When
+	// it is empty the server signs with a random key, so tokens do not
+	// survive a restart.
+	SigningKey     string   `json:"signing_key"`
+	SigningKeyFile string   `json:"signing_key_file"`
+	Issuer         string   `json:"issuer"`
+	TokenTTL       Duration `json:"token_ttl"`
+
+	// AdminEmail, when set, names a user created at startup if no user has
+	// that email, so a fresh database can be logged into.
+	AdminEmail        string `json:"admin_email"`
+	AdminPassword     string `json:"admin_password"`
+	AdminPasswordFile string `json:"admin_password_file"`
+}
+
+const minSigningKeyLength = 32
+
+type AuditConfig struct {
+	// CheckpointKey is the base64 Ed25519 seed that signs exported log
+	// checkpoints, as printed by "server logchain keygen".
+	CheckpointKey     string `json:"checkpoint_key"`
+	CheckpointKeyFile string `json:"checkpoint_key_file"`
+}
+
+type TrashConfig struct {
+	// Retention is how long deleted authors, IP assets and publications stay
+	// in the trash before they are purged; zero keeps them forever.
+	Retention     Duration `json:"retention"`
+	PurgeInterval Duration `json:"purge_interval"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		Database: DBConfig{
+			Host:            "127.0.0.1",
+			Port:            "5432",
+			Name:            "rms_db",
+			User:            "postgres",
+			SSLMode:         "disable",
+			MaxOpenConns:    25,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: Duration(30 * time.Minute),
+			ConnMaxIdleTime: Duration(5 * time.Minute),
+			ConnectAttempts: 5,
+			ConnectBackoff:  Duration(time.Second),
+		},
+		Auth: AuthConfig{
+			Issuer:   "rms",
+			TokenTTL: Duration(time.Hour),
+		},
+		Trash: TrashConfig{
+			Retention:     Duration(30 * 24 * time.Hour),
+			PurgeInterval: Duration(time.Hour),
+		},
+	}
+}
+
+// DSN returns the libpq connection string for the database.
+func (c DBConfig) DSN() string {
+	return fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s",
+		dsnQuote(c.Host),
+		dsnQuote(c.Port),
+		dsnQuote(c.User),
+		dsnQuote(c.Name),
+		dsnQuote(c.Password),
+		dsnQuote(c.SSLMode),
+	)
+}
+
+// String describes the database without its password so it is safe to log.
+func (c DBConfig) String() string {
+	return fmt.Sprintf("postgres://%s@%s:%s/%s", c.User, c.Host, c.Port, c.Name)
+}
+
+func dsnQuote(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `'`, `\'`)
+	return "'" + v + "'"
+}
+
+// Duration is a time.Duration that reads from JSON strings such as "30s".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"30s\": %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// configSetting is a setting that can be given as a flag or as an
+// environment variable. The variable name is RMS_ followed by the upper-cased
+// flag name, e.g. -db-host and RMS_DB_HOST.
+type configSetting struct {
+	name  string
+	usage string
+	apply func(cfg *Config, value string) error
+}
+
+var settings = []configSetting{
+	{"db-host", "database host", setString(func(c *Config) *string { return &c.Database.Host })},
+	{"db-port", "database port", setString(func(c *Config) *string { return &c.Database.Port })},
+	{"db-name", "database name", setString(func(c *Config) *string { return &c.Database.Name })},
+	{"db-user", "database user", setString(func(c *Config) *string { return &c.Database.User })},
+	{"db-password", "database password; prefer -db-password-file", setString(func(c *Config) *string { return &c.Database.Password })},
+	{"db-password-file", "file containing the database password", setString(func(c *Config) *string { return &c.Database.PasswordFile })},
+	{"db-sslmode", "database sslmode", setString(func(c *Config) *string { return &c.Database.SSLMode })},
+	{"db-max-open-conns", "maximum open database connections", setInt(func(c *Config) *int { return &c.Database.MaxOpenConns })},
+	{"db-max-idle-conns", "maximum idle database connections", setInt(func(c *Config) *int { return &c.Database.MaxIdleConns })},
+	{"db-conn-max-lifetime", "maximum lifetime of a database connection", setDuration(func(c *Config) *Duration { return &c.Database.ConnMaxLifetime })},
+	{"db-conn-max-idle-time", "maximum idle time of a database connection", setDuration(func(c *Config) *Duration { return &c.Database.ConnMaxIdleTime })},
+	{"db-connect-attempts", "database connection attempts at startup", setInt(func(c *Config) *int { return &c.Database.ConnectAttempts })},
+	{"db-connect-backoff", "initial wait between database connection attempts", setDuration(func(c *Config) *Duration { return &c.Database.ConnectBackoff })},
+	{"auth-signing-key", "access token signing key; prefer -auth-signing-key-file", setString(func(c *Config) *string { return &c.Auth.SigningKey })},
+	{"auth-signing-key-file", "file containing the access token signing key", setString(func(c *Config) *string { return &c.Auth.SigningKeyFile })},
+	{"auth-issuer", "issuer of access tokens", setString(func(c *Config) *string { return &c.Auth.Issuer })},
+	{"auth-token-ttl", "lifetime of access tokens", setDuration(func(c *Config) *Duration { return &c.Auth.TokenTTL })},
+	{"auth-admin-email", "email of the admin user created at startup", setString(func(c *Config) *string { return &c.Auth.AdminEmail })},
+	{"auth-admin-password", "password of the startup admin user; prefer -auth-admin-password-file", setString(func(c *Config) *string { return &c.Auth.AdminPassword })},
+	{"auth-admin-password-file", "file containing the password of the startup admin user", setString(func(c *Config) *string { return &c.Auth.AdminPasswordFile })},
+	{"audit-checkpoint-key", "log checkpoint signing key; prefer -audit-checkpoint-key-file", setString(func(c *Config) *string { return &c.Audit.CheckpointKey })},
+	{"audit-checkpoint-key-file", "file containing the log checkpoint signing key", setString(func(c *Config) *string { return &c.Audit.CheckpointKeyFile })},
+	{"trash-retention", "how long deleted records are kept before they are purged; 0 keeps them forever", setDuration(func(c *Config) *Duration { return &c.Trash.Retention })},
+	{"trash-purge-interval", "how often expired deleted records are purged", setDuration(func(c *Config) *Duration { return &c.Trash.PurgeInterval })},
+}
+
+func setString(field func(*Config) *string) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		*field(c) = v
+		return nil
+	}
+}
+
+func setInt(field func(*Config) *int) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		*field(c) = n
+		return nil
+	}
+}
+
+func setDuration(field func(*Config) *Duration) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*field(c) = Duration(d)
+		return nil
+	}
+}
+
+func envName(flagName string) string {
+	return "RMS_" + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+var (
+	configPath = flag.String("config", os.Getenv("RMS_CONFIG"), "path to a JSON config file (env RMS_CONFIG)")
+
+	// flagValues holds the setting flags given on the command line.
+	flagValues = map[string]string{}
+)
+
+func init() {
+	for _, s := range settings {
+		name := s.name
+		flag.Func(name, fmt.Sprintf("%s (env %s)", s.usage, envName(name)), func(v string) error {
+			flagValues[name] = v
+			return nil
+		})
+	}
+}
+
+// loadConfig resolves the configuration. It must be called after flag.Parse.
+func loadConfig(path string) (Config, error) {
+	cfg := defaultConfig()
+
+	// A secret file is read as soon as the source that names it is applied,
+	// so that it overrides the secret given by sources of lower precedence
+	// and is overridden by those of higher precedence.
+	secrets := []struct {
+		what    string
+		setting string
+		file    *string
+		value   *string
+	}{
+		{"database password", "db-password-file", &cfg.Database.PasswordFile, &cfg.Database.Password},
+		{"signing key", "auth-signing-key-file", &cfg.Auth.SigningKeyFile, &cfg.Auth.SigningKey},
+		{"admin password", "auth-admin-password-file", &cfg.Auth.AdminPasswordFile, &cfg.Auth.AdminPassword},
+		{"checkpoint key", "audit-checkpoint-key-file", &cfg.Audit.CheckpointKeyFile, &cfg.Audit.CheckpointKey},
+	}
+	readSecrets := func(given func(setting string) bool) error {
+		for _, secret := range secrets {
+			if *secret.file == "" || !given(secret.setting) {
+				continue
+			}
+			data, err := os.ReadFile(*secret.file)
+			if err != nil {
+				return fmt.Errorf("reading %s file: %w", secret.what, err)
+			}
+			*secret.value = strings.TrimSpace(string(data))
+		}
+		return nil
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return cfg, fmt.Errorf("reading config file: %w", err)
+		}
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&cfg); err != nil {
+			return cfg, fmt.Errorf("parsing config file %s: %w", path, err)
+		}
+		if err := readSecrets(func(string) bool { return true }); err != nil {
+			return cfg, err
+		}
+	}
+
+	for _, s := range settings {
+		if v, ok := os.LookupEnv(envName(s.name)); ok {
+			if err := s.apply(&cfg, v); err != nil {
+				return cfg, fmt.Errorf("invalid %s: %w", envName(s.name), err)
+			}
+		}
+	}
+	err := readSecrets(func(setting string) bool {
+		_, ok := os.LookupEnv(envName(setting))
+		return ok
+	})
+	if err != nil {
+		return cfg, err
+	}
+	for _, s := range settings {
+		if v, ok := flagValues[s.name]; ok {
+			if err := s.apply(&cfg, v); err != nil {
+				return cfg, fmt.Errorf("invalid -%s: %w", s.name, err)
+			}
+		}
+	}
+	err = readSecrets(func(setting string) bool {
+		_, ok := flagValues[setting]
+		return ok
+	})
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.Database.ConnectAttempts < 1 {
+		return cfg, errors.New("database connect attempts must be at least 1")
+	}
+	if cfg.Auth.SigningKey != "" && len(cfg.Auth.SigningKey) < minSigningKeyLength {
+		return cfg, fmt.Errorf("signing key must be at least %d bytes", minSigningKeyLength)
+	}
+	if cfg.Auth.TokenTTL <= 0 {
+		return cfg, errors.New("token TTL must be positive")
+	}
+	if cfg.Auth.AdminEmail != "" && cfg.Auth.AdminPassword == "" {
+		return cfg, errors.New("admin email is set without an admin password")
+	}
+	if cfg.Trash.Retention < 0 {
+		return cfg, errors.New("trash retention must not be negative")
+	}
+	if cfg.Trash.PurgeInterval <= 0 {
+		return cfg, errors.New("trash purge interval must be positive")
+	}
+	return cfg, nil
+}
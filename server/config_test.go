@@ -0,0 +1,94 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// setFlags stands in for setting flags given on the command line.
+func setFlags(t *testing.T, flags map[string]string) {
+	t.Helper()
+	saved := flagValues
+	flagValues = flags
+	t.Cleanup(func() { flagValues = saved })
+}
+
+func writeFile(t *testing.T, name, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := writeFile(t, "config.json", `{"database": {"host": "db.internal", "port": "6432", "user": "rms", "conn_max_lifetime": "1h"}}`)
+	t.Setenv("RMS_DB_PORT", "7432")
+	t.Setenv("RMS_DB_USER", "rms_env")
+	setFlags(t, map[string]string{"db-user": "rms_flag", "db-password-file": writeFile(t, "password", "s3cret\n")})
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := cfg.Database
+	if db.Host != "db.internal" || db.Port != "7432" || db.User != "rms_flag" || db.Name != "rms_db" {
+		t.Errorf("resolved %s", db)
+	}
+	if db.Password != "s3cret" {
+		t.Errorf("password %q, want the trimmed contents of the password file", db.Password)
+	}
+	if db.ConnMaxLifetime != Duration(time.Hour) || db.MaxOpenConns != 25 {
+		t.Errorf("pool settings %v and %d", db.ConnMaxLifetime, db.MaxOpenConns)
+	}
+}
+
+func TestLoadConfigSecretFiles(t *testing.T) {
+	path := writeFile(t, "config.json", `{"database": {"password_file": "`+writeFile(t, "password", "from-config-file")+`"}}`)
+	for name, tt := range map[string]struct {
+		env   map[string]string
+		flags map[string]string
+		want  string
+	}{
+		"config file":                   {want: "from-config-file"},
+		"env over config file":          {env: map[string]string{"RMS_DB_PASSWORD": "from-env"}, want: "from-env"},
+		"flag over env file":            {env: map[string]string{"RMS_DB_PASSWORD_FILE": writeFile(t, "env-password", "from-env-file")}, flags: map[string]string{"db-password": "from-flag"}, want: "from-flag"},
+		"flag file over env":            {env: map[string]string{"RMS_DB_PASSWORD": "from-env"}, flags: map[string]string{"db-password-file": writeFile(t, "flag-password", "from-flag-file")}, want: "from-flag-file"},
+		"file over value of one source": {flags: map[string]string{"db-password": "from-flag", "db-password-file": writeFile(t, "flag-password", "from-flag-file")}, want: "from-flag-file"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			setFlags(t, tt.flags)
+			cfg, err := loadConfig(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Database.Password != tt.want {
+				t.Errorf("password %q, want %q", cfg.Database.Password, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	for name, data := range map[string]string{
+		"unknown field":          `{"database": {"hostname": "db.internal"}}`,
+		"bad duration":           `{"database": {"connect_backoff": 5}}`,
+		"no attempts":            `{"database": {"connect_attempts": 0}}`,
+		"short key":              `{"auth": {"signing_key": "0123456789"}}`,
+		"admin without password": `{"auth": {"admin_email": "admin@example.com"}}`,
+		"not a JSON value":       `database: {}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			setFlags(t, map[string]string{})
+			if _, err := loadConfig(writeFile(t, "config.json", data)); err == nil {
+				t.Error("loaded an invalid config")
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"time"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// deletedAtToProto formats when a record was trashed, or "" for live records.
+func deletedAtToProto(deletedAt gorm.DeletedAt) string {
+	if !deletedAt.Valid {
+		return ""
+	}
+	return deletedAt.Time.UTC().Format(time.RFC3339)
+}
+
+// Date precisions say how much of a stored date is known.
+const (
+	precisionYear  = "year"
+	precisionMonth = "month"
+	precisionDay   = "day"
+)
+
+// dateFromProto returns the stored form of a date, nil when it is unset. A
+// date known only to the year or month is stored as its first day, with its
+// precision alongside.
+func dateFromProto(d *date.Date) *time.Time {
+	if d.GetYear() == 0 {
+		return nil
+	}
+	month, day := d.GetMonth(), d.GetDay()
+	if month == 0 {
+		month = 1
+	}
+	if day == 0 {
+		day = 1
+	}
+	t := time.Date(int(d.GetYear()), time.Month(month), int(day), 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+// datePrecision returns the precision of a date, "" when it is unset.
+func datePrecision(d *date.Date) string {
+	switch {
+	case d.GetYear() == 0:
+		return ""
+	case d.GetMonth() == 0:
+		return precisionYear
+	case d.GetDay() == 0:
+		return precisionMonth
+	}
+	return precisionDay
+}
+
+// dateToProto returns a stored date to the precision it was stored with.
+func dateToProto(t *time.Time, precision string) *date.Date {
+	if t == nil {
+		return nil
+	}
+	switch precision {
+	case precisionYear:
+		return &date.Date{Year: int32(t.Year())}
+	case precisionMonth:
+		return &date.Date{Year: int32(t.Year()), Month: int32(t.Month())}
+	}
+	return &date.Date{Year: int32(t.Year()), Month: int32(t.Month()), Day: int32(t.Day())}
+}
+
+func timestampFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func timestampToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// Author
+func authorFromProto(author *pb.Author) Author {
+	return Author{
+		ID:           author.GetAuthorId(),
+		AuthorName:   author.GetAuthorName(),
+		AuthorGender: author.GetGender(),
+		TypeofAuthor: author.GetTypeOfAuthor(),
+		Affiliation:  author.GetAffiliation(),
+		AuthorEmail:  author.GetEmail(),
+		Version:      author.GetVersion(),
+	}
+}
+
+func authorToProto(author *Author) *pb.Author {
+	return &pb.Author{
+		AuthorId:     author.ID,
+		AuthorName:   author.AuthorName,
+		Gender:       author.AuthorGender,
+		TypeOfAuthor: author.TypeofAuthor,
+		Affiliation:  author.Affiliation,
+		Email:        author.AuthorEmail,
+		DeletedAt:    deletedAtToProto(author.DeletedAt),
+		Version:      author.Version,
+	}
+}
+
+// IP_Asset
+func ipAssetFromProto(ipAsset *pb.IP_Asset) IP_Asset {
+	return IP_Asset{
+		RegistrationNumber:      ipAsset.GetRegistrationNumber(),
+		TitleOfWork:             ipAsset.GetTitleOfWork(),
+		TypeOfDocument:          ipAsset.GetTypeOfDocument(),
+		ClassOfWork:             ipAsset.GetClassOfWork(),
+		DateOfCreation:          dateFromProto(ipAsset.GetDateOfCreation()),
+		DateRegistered:          dateFromProto(ipAsset.GetDateRegistered()),
+		Campus:                  ipAsset.GetCampus(),
+		College:                 ipAsset.GetCollege(),
+		Program:                 ipAsset.GetProgram(),
+		Authors:                 ipAsset.GetAuthors(),
+		Hyperlink:               ipAsset.GetHyperlink(),
+		Status:                  ipAsset.GetStatus(),
+		Certificate:             ipAsset.GetCertificate(),
+		DateOfCreationPrecision: datePrecision(ipAsset.GetDateOfCreation()),
+		DateRegisteredPrecision: datePrecision(ipAsset.GetDateRegistered()),
+		Version:                 ipAsset.GetVersion(),
+	}
+}
+
+func ipAssetToProto(ipAsset *IP_Asset) *pb.IP_Asset {
+	return &pb.IP_Asset{
+		RegistrationNumber: ipAsset.RegistrationNumber,
+		TitleOfWork:        ipAsset.TitleOfWork,
+		TypeOfDocument:     ipAsset.TypeOfDocument,
+		ClassOfWork:        ipAsset.ClassOfWork,
+		DateOfCreation:     dateToProto(ipAsset.DateOfCreation, ipAsset.DateOfCreationPrecision),
+		DateRegistered:     dateToProto(ipAsset.DateRegistered, ipAsset.DateRegisteredPrecision),
+		Campus:             ipAsset.Campus,
+		College:            ipAsset.College,
+		Program:            ipAsset.Program,
+		Authors:            ipAsset.Authors,
+		Hyperlink:          ipAsset.Hyperlink,
+		Status:             ipAsset.Status,
+		Certificate:        ipAsset.Certificate,
+		DeletedAt:          deletedAtToProto(ipAsset.DeletedAt),
+		Version:            ipAsset.Version,
+	}
+}
+
+// Publication
+func publicationFromProto(publication *pb.Publication) Publication {
+	return Publication{
+		PublicationID:          publication.GetPublicationId(),
+		DatePublished:          dateFromProto(publication.GetDatePublished()),
+		Quartile:               publication.GetQuartile(),
+		Authors:                publication.GetAuthors(),
+		Department:             publication.GetDepartment(),
+		College:                publication.GetCollege(),
+		Campus:                 publication.GetCampus(),
+		TitleOfPaper:           publication.GetTitleOfPaper(),
+		TypeOfPublication:      publication.GetTypeOfPublication(),
+		FundingSource:          publication.GetFundingSource(),
+		NumberOfCitation:       publication.GetNumberOfCitation(),
+		GoogleScholarDetails:   publication.GetGoogleScholarDetails(),
+		SDGNo:                  publication.GetSdgNo(),
+		FundingType:            publication.GetFundingType(),
+		NatureOfFunding:        publication.GetNatureOfFunding(),
+		Publisher:              publication.GetPublisher(),
+		Abstract:               publication.GetAbstract(),
+		Draft:                  publication.GetDraft(),
+		DatePublishedPrecision: datePrecision(publication.GetDatePublished()),
+		Version:                publication.GetVersion(),
+	}
+}
+
+func publicationToProto(publication *Publication) *pb.Publication {
+	return &pb.Publication{
+		PublicationId:        publication.PublicationID,
+		DatePublished:        dateToProto(publication.DatePublished, publication.DatePublishedPrecision),
+		Quartile:             publication.Quartile,
+		Authors:              publication.Authors,
+		Department:           publication.Department,
+		College:              publication.College,
+		Campus:               publication.Campus,
+		TitleOfPaper:         publication.TitleOfPaper,
+		TypeOfPublication:    publication.TypeOfPublication,
+		FundingSource:        publication.FundingSource,
+		NumberOfCitation:     publication.NumberOfCitation,
+		GoogleScholarDetails: publication.GoogleScholarDetails,
+		SdgNo:                publication.SDGNo,
+		FundingType:          publication.FundingType,
+		NatureOfFunding:      publication.NatureOfFunding,
+		Publisher:            publication.Publisher,
+		Abstract:             publication.Abstract,
+		Draft:                publication.Draft,
+		DeletedAt:            deletedAtToProto(publication.DeletedAt),
+		Version:              publication.Version,
+	}
+}
+
+// User
+func userFromProto(user *pb.User) User {
+	return User{
+		UserID:      user.GetUserId(),
+		SRCode:      user.GetSrCode(),
+		Email:       user.GetEmail(),
+		Password:    user.GetPassword(),
+		AccountType: user.GetAccountType(),
+		UserContact: user.GetUserContact(),
+		UserImg:     user.GetUserImg(),
+		UserFname:   user.GetUserFname(),
+		UserLname:   user.GetUserLname(),
+		UserMname:   user.GetUserMname(),
+		Version:     user.GetVersion(),
+	}
+}
+
+func userToProto(user *User) *pb.User {
+	return &pb.User{
+		UserId:      user.UserID,
+		SrCode:      user.SRCode,
+		Email:       user.Email,
+		AccountType: user.AccountType,
+		UserContact: user.UserContact,
+		UserImg:     user.UserImg,
+		UserFname:   user.UserFname,
+		UserLname:   user.UserLname,
+		UserMname:   user.UserMname,
+		Version:     user.Version,
+	}
+}
+
+// Log
+func logFromProto(log *pb.Log) Log {
+	return Log{
+		LogID:       log.GetLogId(),
+		DateTime:    timestampFromProto(log.GetDateTime()),
+		UserID:      log.GetUserId(),
+		Activity:    log.GetActivity(),
+		Description: log.GetDescription(),
+		Method:      log.GetMethod(),
+		Entity:      log.GetEntity(),
+		EntityID:    log.GetEntityId(),
+		Changes:     log.GetChanges(),
+		Seq:         log.GetSeq(),
+		PrevHash:    log.GetPrevHash(),
+		Hash:        log.GetHash(),
+	}
+}
+
+func logToProto(log *Log) *pb.Log {
+	return &pb.Log{
+		LogId:       log.LogID,
+		DateTime:    timestampToProto(log.DateTime),
+		UserId:      log.UserID,
+		Activity:    log.Activity,
+		Description: log.Description,
+		Method:      log.Method,
+		Entity:      log.Entity,
+		EntityId:    log.EntityID,
+		Changes:     log.Changes,
+		Seq:         log.Seq,
+		PrevHash:    log.PrevHash,
+		Hash:        log.Hash,
+	}
+}
+
+// Revision
+func revisionToProto(rev *Revision) *pb.Revision {
+	return &pb.Revision{
+		Entity:    rev.Entity,
+		EntityId:  rev.EntityID,
+		Revision:  rev.Revision,
+		UserId:    rev.UserID,
+		Method:    rev.Method,
+		CreatedAt: rev.CreatedAt.UTC().Format(time.RFC3339),
+		Data:      rev.Data,
+	}
+}
+
+// Vocabulary
+func vocabularyTermFromProto(t *pb.VocabularyTerm) VocabularyTerm {
+	return VocabularyTerm{
+		Vocabulary: t.GetVocabulary(),
+		Value:      t.GetValue(),
+		Label:      t.GetLabel(),
+		Position:   t.GetPosition(),
+	}
+}
+
+func vocabularyTermToProto(t *VocabularyTerm) *pb.VocabularyTerm {
+	return &pb.VocabularyTerm{
+		Vocabulary: t.Vocabulary,
+		Value:      t.Value,
+		Label:      t.Label,
+		Position:   t.Position,
+	}
+}
+
+func vocabularyViolationToProto(v *VocabularyViolation) *pb.VocabularyViolation {
+	return &pb.VocabularyViolation{
+		Vocabulary: v.Vocabulary,
+		Entity:     v.Entity,
+		EntityId:   v.EntityID,
+		Value:      v.Value,
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Handlers return gRPC statuses, never bare errors, so that callers can tell
+// a missing record from a bad request or a database outage. Statuses carry
+// google.rpc error details: ResourceInfo for NotFound and AlreadyExists,
+// BadRequest for InvalidArgument, ErrorInfo for Aborted and Unavailable, and
+// RetryInfo for Unavailable.
+
+// errorDomain is the ErrorInfo domain of the reasons below.
+const errorDomain = "rms"
+
+const (
+	reasonStaleVersion        = "STALE_VERSION"
+	reasonDatabaseUnavailable = "DATABASE_UNAVAILABLE"
+)
+
+// unavailableRetryDelay is how long clients are told to wait before retrying
+// a call that failed because the database was unreachable.
+const unavailableRetryDelay = time.Second
+
+// withDetails attaches details to st, or returns st alone when they cannot be
+// encoded.
+func withDetails(st *status.Status, details ...protoiface.MessageV1) error {
+	if d, err := st.WithDetails(details...); err == nil {
+		return d.Err()
+	}
+	return st.Err()
+}
+
+// invalidArgument reports one invalid request field.
+func invalidArgument(field, description string) error {
+	var v violations
+	v.add(field, description)
+	return v.err()
+}
+
+func errNotFound(resource, name string) error {
+	msg := resource + " not found"
+	if name != "" {
+		msg = fmt.Sprintf("%s %s not found", resource, name)
+	}
+	return withDetails(status.New(codes.NotFound, msg), &errdetails.ResourceInfo{
+		ResourceType: resource,
+		ResourceName: name,
+		Description:  msg,
+	})
+}
+
+func errAlreadyExists(resource, name string) error {
+	msg := fmt.Sprintf("%s %s already exists", resource, name)
+	return withDetails(status.New(codes.AlreadyExists, msg), &errdetails.ResourceInfo{
+		ResourceType: resource,
+		ResourceName: name,
+		Description:  msg,
+	})
+}
+
+// errStale reports an update or delete whose expected version no longer
+// matches the stored record.
+func errStale(resource string) error {
+	return withDetails(status.Newf(codes.Aborted, "%s was changed since it was read; reload it and retry", resource), &errdetails.ErrorInfo{
+		Reason:   reasonStaleVersion,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"resource": resource},
+	})
+}
+
+func errUnavailable() error {
+	return withDetails(status.New(codes.Unavailable, "the database is unavailable; retry later"),
+		&errdetails.ErrorInfo{Reason: reasonDatabaseUnavailable, Domain: errorDomain},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(unavailableRetryDelay)},
+	)
+}
+
+// storeError converts an error returned by a store while handling the named
+// resource into a status. name is empty for lists. Errors that are already
+// statuses pass through; unexpected ones are logged and reported as Internal
+// without their text, which may hold SQL.
+func storeError(err error, resource, name string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return errNotFound(resource, name)
+	case errors.Is(err, ErrAlreadyExists):
+		return errAlreadyExists(resource, name)
+	case errors.Is(err, ErrStaleVersion):
+		return errStale(resource)
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "the request was canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "the request timed out")
+	case databaseUnavailable(err):
+		log.Printf("Database unavailable handling %s %s: %v", resource, name, err)
+		return errUnavailable()
+	}
+	log.Printf("Handling %s %s: %v", resource, name, err)
+	return status.Errorf(codes.Internal, "%s request unsuccessful", resource)
+}
+
+// databaseUnavailable reports whether err means the database could not be
+// reached, as opposed to rejecting a statement.
+func databaseUnavailable(err error) bool {
+	var netErr net.Error
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone):
+		return true
+	case errors.As(err, &netErr):
+		// Failed connection attempts wrap the dial error.
+		return true
+	case errors.As(err, &pgErr):
+		// Class 08 is connection exceptions; 57P01-57P03 are shutdowns and
+		// a server that cannot accept connections yet.
+		return strings.HasPrefix(pgErr.Code, "08") || pgErr.Code == "57P01" || pgErr.Code == "57P02" || pgErr.Code == "57P03"
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStoreError(t *testing.T) {
+	for _, tt := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{fmt.Errorf("reading: %w", ErrNotFound), codes.NotFound},
+		{ErrAlreadyExists, codes.AlreadyExists},
+		{ErrStaleVersion, codes.Aborted},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{&pgconn.PgError{Code: "08006"}, codes.Unavailable},
+		{&pgconn.PgError{Code: "23505", Message: "duplicate key value violates unique constraint"}, codes.Internal},
+		{status.Error(codes.InvalidArgument, "bad"), codes.InvalidArgument},
+	} {
+		err := storeError(tt.err, "author", "a1")
+		if got := status.Code(err); got != tt.code {
+			t.Errorf("storeError(%v) = %v, want %v", tt.err, got, tt.code)
+		}
+	}
+	if st := status.Convert(storeError(errors.New("SELECT * FROM authors"), "author", "a1")); st.Message() != "author request unsuccessful" {
+		t.Errorf("an unexpected error reported %q", st.Message())
+	}
+}
+
+func TestErrorDetails(t *testing.T) {
+	ts := newTestServer(t)
+	_, err := ts.GetAuthor(context.Background(), &pb.ReadAuthorRequest{AuthorId: "missing"})
+	wantCode(t, err, codes.NotFound)
+	var info *errdetails.ResourceInfo
+	for _, d := range status.Convert(err).Details() {
+		if d, ok := d.(*errdetails.ResourceInfo); ok {
+			info = d
+		}
+	}
+	if info.GetResourceType() != "author" || info.GetResourceName() != "missing" {
+		t.Errorf("details %v, want the resource that was not found", info)
+	}
+}
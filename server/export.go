@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// entityLog names the activity log to ExportRecords.
+const entityLog = "log"
+
+// exportColumns are the fields exported for each entity, in column order.
+// New fields go at the end, so that existing columns keep their positions.
+var exportColumns = map[string][]string{
+	entityAuthor: {"author_id", "author_name", "gender", "type_of_author", "affiliation", "email", "version"},
+	entityIPAsset: {
+		"registration_number", "title_of_work", "type_of_document", "class_of_work", "date_of_creation",
+		"date_registered", "campus", "college", "program", "authors", "hyperlink", "status", "certificate",
+		"version",
+	},
+	entityPublication: {
+		"publication_id", "title_of_paper", "date_published", "quartile", "authors", "department", "college",
+		"campus", "type_of_publication", "funding_source", "number_of_citation", "google_scholar_details",
+		"sdg_no", "funding_type", "nature_of_funding", "publisher", "abstract", "version", "draft",
+	},
+	entityUser: {
+		"user_id", "sr_code", "email", "account_type", "user_contact", "user_img", "user_fname", "user_lname",
+		"user_mname", "version",
+	},
+	entityLog: {
+		"log_id", "seq", "date_time", "user_id", "activity", "description", "method", "entity", "entity_id",
+		"changes", "prev_hash", "hash",
+	},
+}
+
+// exportChunkSize is the size above which the exported data is sent.
+const exportChunkSize = 64 << 10
+
+// streamInterceptor runs interceptors for server-streaming RPCs, whose one
+// request they see when the handler receives it.
+func streamInterceptor(interceptors []grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &interceptedStream{
+			ServerStream: ss,
+			ctx:          ss.Context(),
+			interceptors: interceptors,
+			info:         &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod},
+		})
+	}
+}
+
+// interceptedStream passes the request it receives through interceptors,
+// and from then on returns the context they hand on.
+type interceptedStream struct {
+	grpc.ServerStream
+	ctx          context.Context
+	interceptors []grpc.UnaryServerInterceptor
+	info         *grpc.UnaryServerInfo
+}
+
+func (s *interceptedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *interceptedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	_, err := chainUnary(s.interceptors, s.info, func(ctx context.Context, req any) (any, error) {
+		s.ctx = ctx
+		return nil, nil
+	})(s.ctx, m)
+	return err
+}
+
+// exportPage lists a page of the records to export.
+func (s *server) exportPage(ctx context.Context, req *pb.ExportRecordsRequest, token string) ([]proto.Message, string, error) {
+	var records []proto.Message
+	switch req.GetEntity() {
+	case entityAuthor:
+		res, err := s.call(ctx, "GetAuthors", &pb.ReadAuthorsRequest{PageSize: maxPageSize, PageToken: token, Filter: req.GetFilter(), OrderBy: req.GetOrderBy()},
+			func(ctx context.Context, req any) (any, error) {
+				return s.GetAuthors(ctx, req.(*pb.ReadAuthorsRequest))
+			})
+		if err != nil {
+			return nil, "", err
+		}
+		for _, r := range res.(*pb.ReadAuthorsResponse).GetAuthors() {
+			records = append(records, r)
+		}
+		return records, res.(*pb.ReadAuthorsResponse).GetNextPageToken(), nil
+	case entityIPAsset:
+		res, err := s.call(ctx, "GetIP_Assets", &pb.ReadIP_AssetsRequest{PageSize: maxPageSize, PageToken: token, Filter: req.GetFilter(), OrderBy: req.GetOrderBy()},
+			func(ctx context.Context, req any) (any, error) {
+				return s.GetIP_Assets(ctx, req.(*pb.ReadIP_AssetsRequest))
+			})
+		if err != nil {
+			return nil, "", err
+		}
+		for _, r := range res.(*pb.ReadIP_AssetsResponse).GetIpAssets() {
+			records = append(records, r)
+		}
+		return records, res.(*pb.ReadIP_AssetsResponse).GetNextPageToken(), nil
+	case entityPublication:
+		res, err := s.call(ctx, "GetPublications", &pb.ReadPublicationsRequest{PageSize: maxPageSize, PageToken: token, Filter: req.GetFilter(), OrderBy: req.GetOrderBy()},
+			func(ctx context.Context, req any) (any, error) {
+				return s.GetPublications(ctx, req.(*pb.ReadPublicationsRequest))
+			})
+		if err != nil {
+			return nil, "", err
+		}
+		for _, r := range res.(*pb.ReadPublicationsResponse).GetPublications() {
+			records = append(records, r)
+		}
+		return records, res.(*pb.ReadPublicationsResponse).GetNextPageToken(), nil
+	case entityUser:
+		res, err := s.call(ctx, "GetUsers", &pb.ReadUsersRequest{PageSize: maxPageSize, PageToken: token, Filter: req.GetFilter(), OrderBy: req.GetOrderBy()},
+			func(ctx context.Context, req any) (any, error) {
+				return s.GetUsers(ctx, req.(*pb.ReadUsersRequest))
+			})
+		if err != nil {
+			return nil, "", err
+		}
+		for _, r := range res.(*pb.ReadUsersResponse).GetUsers() {
+			records = append(records, r)
+		}
+		return records, res.(*pb.ReadUsersResponse).GetNextPageToken(), nil
+	case entityLog:
+		res, err := s.call(ctx, "GetLogs", &pb.ReadLogsRequest{PageSize: maxPageSize, PageToken: token, Filter: req.GetFilter(), OrderBy: req.GetOrderBy()},
+			func(ctx context.Context, req any) (any, error) {
+				return s.GetLogs(ctx, req.(*pb.ReadLogsRequest))
+			})
+		if err != nil {
+			return nil, "", err
+		}
+		for _, r := range res.(*pb.ReadLogsResponse).GetLogs() {
+			records = append(records, r)
+		}
+		return records, res.(*pb.ReadLogsResponse).GetNextPageToken(), nil
+	}
+	return nil, "", invalidArgument("entity", fmt.Sprintf("%q cannot be exported", req.GetEntity()))
+}
+
+// exportValue returns a field of a record as it is exported: a string, a
+// number, a bool, or nil for an unset date or time.
+func exportValue(m protoreflect.Message, name string) any {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return m.Get(fd).String()
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return m.Get(fd).Int()
+	case protoreflect.BoolKind:
+		return m.Get(fd).Bool()
+	case protoreflect.MessageKind:
+		if !m.Has(fd) {
+			return nil
+		}
+		switch v := m.Get(fd).Message().Interface().(type) {
+		case *date.Date:
+			return formatDate(v)
+		case *timestamppb.Timestamp:
+			return v.AsTime().UTC().Format(time.RFC3339)
+		}
+	}
+	return nil
+}
+
+// formatDate formats a date in ISO 8601 to the precision it is known to:
+// "2021", "2021-03" or "2021-03-04".
+func formatDate(d *date.Date) string {
+	switch datePrecision(d) {
+	case precisionYear:
+		return fmt.Sprintf("%04d", d.GetYear())
+	case precisionMonth:
+		return fmt.Sprintf("%04d-%02d", d.GetYear(), d.GetMonth())
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.GetYear(), d.GetMonth(), d.GetDay())
+}
+
+// writeExportRecord appends a record to an export in format.
+func writeExportRecord(buf *bytes.Buffer, w *csv.Writer, format string, columns []string, record proto.Message) error {
+	m := record.ProtoReflect()
+	if format == "csv" {
+		row := make([]string, len(columns))
+		for i, name := range columns {
+			switch v := exportValue(m, name).(type) {
+			case string:
+				row[i] = v
+			case int64:
+				row[i] = strconv.FormatInt(v, 10)
+			case bool:
+				row[i] = strconv.FormatBool(v)
+			}
+		}
+		w.Write(row)
+		w.Flush()
+		return w.Error()
+	}
+
+	buf.WriteByte('{')
+	for i, name := range columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		value, err := json.Marshal(exportValue(m, name))
+		if err != nil {
+			return err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteString("}\n")
+	return nil
+}
+
+// ExportRecords lists the records to export one page at a time through the
+// list RPC of their entity, which authorizes, filters and orders them as it
+// does for any caller, and streams each page out as soon as it is written.
+func (s *server) ExportRecords(req *pb.ExportRecordsRequest, stream pb.RMSService_ExportRecordsServer) error {
+	fmt.Println("Export Records", req.GetEntity(), req.GetFormat())
+	ctx := stream.Context()
+	columns := exportColumns[req.GetEntity()]
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if req.GetFormat() == "csv" {
+		w.Write(columns)
+		w.Flush()
+	}
+
+	send := func() error {
+		if buf.Len() == 0 {
+			return nil
+		}
+		err := stream.Send(&pb.ExportRecordsChunk{Data: buf.Bytes()})
+		buf.Reset()
+		return err
+	}
+	token := ""
+	for {
+		records, next, err := s.exportPage(ctx, req, token)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err := writeExportRecord(&buf, w, req.GetFormat(), columns, record); err != nil {
+				return err
+			}
+			if buf.Len() >= exportChunkSize {
+				if err := send(); err != nil {
+					return err
+				}
+			}
+		}
+		if next == "" {
+			return send()
+		}
+		token = next
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// exportStream collects the data an export sends.
+type exportStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data bytes.Buffer
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(chunk *pb.ExportRecordsChunk) error {
+	s.data.Write(chunk.GetData())
+	return nil
+}
+
+func export(ts *testServer, ctx context.Context, req *pb.ExportRecordsRequest) (string, error) {
+	stream := &exportStream{ctx: ctx}
+	err := ts.ExportRecords(req, stream)
+	return stream.data.String(), err
+}
+
+func TestExportRecords(t *testing.T) {
+	ts := newTestServer(t)
+	for _, name := range []string{"Juan Dela Cruz", "Maria Santos"} {
+		if _, err := call(ts.as(roleAdmin), ts, "CreateAuthor", (*server).CreateAuthor, &pb.CreateAuthorRequest{Author: &pb.Author{AuthorName: name}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	csv, err := export(ts, ts.as(roleAdmin), &pb.ExportRecordsRequest{Entity: entityAuthor, Format: "csv", Filter: `author_name = "Maria Santos"`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(csv), "\n")
+	if len(lines) != 2 || lines[0] != strings.Join(exportColumns[entityAuthor], ",") || !strings.Contains(lines[1], ",Maria Santos,") {
+		t.Errorf("exported CSV:\n%s", csv)
+	}
+
+	jsonl, err := export(ts, ts.as(roleAdmin), &pb.ExportRecordsRequest{Entity: entityAuthor, Format: "jsonl", OrderBy: "author_name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines = strings.Split(strings.TrimSpace(jsonl), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"author_name":"Juan Dela Cruz"`) || !strings.Contains(lines[1], `"version":1`) {
+		t.Errorf("exported JSON lines:\n%s", jsonl)
+	}
+}
+
+func TestExportRecordsErrors(t *testing.T) {
+	ts := newTestServer(t)
+	_, err := export(ts, ts.as(roleAdmin), &pb.ExportRecordsRequest{Entity: "vocabulary", Format: "csv"})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = export(ts, ts.as(roleStudent), &pb.ExportRecordsRequest{Entity: entityUser, Format: "csv"})
+	wantCode(t, err, codes.PermissionDenied)
+}
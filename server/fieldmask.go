@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Update masks name fields by their proto names; these map them to the Go
+// field names the store's Update methods take. Keys, versions, trash times
+// and linked authors cannot be set through a mask.
+var (
+	authorMaskFields = map[string]string{
+		"author_name":    "AuthorName",
+		"gender":         "AuthorGender",
+		"type_of_author": "TypeofAuthor",
+		"affiliation":    "Affiliation",
+		"email":          "AuthorEmail",
+	}
+
+	ipAssetMaskFields = map[string]string{
+		"title_of_work":    "TitleOfWork",
+		"type_of_document": "TypeOfDocument",
+		"class_of_work":    "ClassOfWork",
+		"date_of_creation": "DateOfCreation",
+		"date_registered":  "DateRegistered",
+		"campus":           "Campus",
+		"college":          "College",
+		"program":          "Program",
+		"authors":          "Authors",
+		"hyperlink":        "Hyperlink",
+		"status":           "Status",
+		"certificate":      "Certificate",
+	}
+
+	publicationMaskFields = map[string]string{
+		"date_published":         "DatePublished",
+		"quartile":               "Quartile",
+		"authors":                "Authors",
+		"department":             "Department",
+		"college":                "College",
+		"campus":                 "Campus",
+		"title_of_paper":         "TitleOfPaper",
+		"type_of_publication":    "TypeOfPublication",
+		"funding_source":         "FundingSource",
+		"number_of_citation":     "NumberOfCitation",
+		"google_scholar_details": "GoogleScholarDetails",
+		"sdg_no":                 "SDGNo",
+		"funding_type":           "FundingType",
+		"nature_of_funding":      "NatureOfFunding",
+		"publisher":              "Publisher",
+		"abstract":               "Abstract",
+		"draft":                  "Draft",
+	}
+
+	userMaskFields = map[string]string{
+		"sr_code":      "SRCode",
+		"email":        "Email",
+		"password":     "Password",
+		"account_type": "AccountType",
+		"user_contact": "UserContact",
+		"user_img":     "UserImg",
+		"user_fname":   "UserFname",
+		"user_lname":   "UserLname",
+		"user_mname":   "UserMname",
+	}
+
+	vocabularyTermMaskFields = map[string]string{
+		"label":    "Label",
+		"position": "Position",
+	}
+)
+
+// precisionFields maps date fields to the fields holding their precision,
+// which a mask naming the date updates as well.
+var precisionFields = map[string]string{
+	"DateOfCreation": "DateOfCreationPrecision",
+	"DateRegistered": "DateRegisteredPrecision",
+	"DatePublished":  "DatePublishedPrecision",
+}
+
+// maskFields returns the Go field names an update mask names, or nil for an
+// empty mask.
+func maskFields(mask *fieldmaskpb.FieldMask, editable map[string]string) ([]string, error) {
+	var fields []string
+	seen := map[string]bool{}
+	for _, path := range mask.GetPaths() {
+		field, ok := editable[path]
+		if !ok {
+			return nil, invalidArgument("update_mask", fmt.Sprintf("%q is not an editable field", path))
+		}
+		if !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+			if precision, ok := precisionFields[field]; ok {
+				fields = append(fields, precision)
+			}
+		}
+	}
+	return fields, nil
+}
+
+// hasField reports whether fields names field.
+func hasField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,666 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// List RPCs accept a filter in a subset of the AIP-160 grammar:
+//
+//	expression  = factor { "AND" factor }
+//	factor      = term { "OR" term }
+//	term        = [ "NOT" ] ( "(" expression ")" | comparison )
+//	comparison  = field ( "=" | "!=" | "<" | "<=" | ">" | ">=" | ":" ) value
+//
+// As in AIP-160, OR binds tighter than AND. Values are bare words such as
+// Q1, 42 or 2021-06-30, or quoted strings. ":" is a case-insensitive
+// substring match on text fields. Dates are written in ISO 8601, where
+// 2021-06 and 2021 stand for the first day of the month or year, and times
+// in quoted RFC 3339 such as "2021-06-30T08:00:00Z", and booleans as true
+// or false. A date range is
+//
+//	date_published >= 2021 AND date_published < 2022
+//
+// Records without a date compare as earlier than every date.
+//
+// Only the fields listed in an entity's querySchema can be filtered or
+// ordered on, and values are always passed to SQL as parameters.
+
+const (
+	maxFilterLength = 2048
+	maxFilterDepth  = 32
+)
+
+type fieldKind int
+
+const (
+	textField fieldKind = iota
+	intField
+	dateField
+	timeField
+	boolField
+)
+
+// queryField is a filterable column. Filter field names are the column
+// names; goField names the matching struct field for the memory store.
+type queryField struct {
+	name    string
+	goField string
+	kind    fieldKind
+}
+
+type querySchema struct {
+	key    string
+	fields map[string]queryField
+}
+
+func newQuerySchema(key string, fields ...queryField) *querySchema {
+	s := &querySchema{key: key, fields: map[string]queryField{}}
+	for _, f := range fields {
+		s.fields[f.name] = f
+	}
+	return s
+}
+
+var (
+	authorQuery = newQuerySchema("author_id",
+		queryField{"author_id", "ID", textField},
+		queryField{"author_name", "AuthorName", textField},
+		queryField{"gender", "AuthorGender", textField},
+		queryField{"type_of_author", "TypeofAuthor", textField},
+		queryField{"affiliation", "Affiliation", textField},
+		queryField{"email", "AuthorEmail", textField},
+	)
+	ipAssetQuery = newQuerySchema("registration_number",
+		queryField{"registration_number", "RegistrationNumber", textField},
+		queryField{"title_of_work", "TitleOfWork", textField},
+		queryField{"type_of_document", "TypeOfDocument", textField},
+		queryField{"class_of_work", "ClassOfWork", textField},
+		queryField{"date_of_creation", "DateOfCreation", dateField},
+		queryField{"date_registered", "DateRegistered", dateField},
+		queryField{"campus", "Campus", textField},
+		queryField{"college", "College", textField},
+		queryField{"program", "Program", textField},
+		queryField{"authors", "Authors", textField},
+		queryField{"status", "Status", textField},
+	)
+	publicationQuery = newQuerySchema("publication_id",
+		queryField{"publication_id", "PublicationID", textField},
+		queryField{"date_published", "DatePublished", dateField},
+		queryField{"quartile", "Quartile", textField},
+		queryField{"authors", "Authors", textField},
+		queryField{"department", "Department", textField},
+		queryField{"college", "College", textField},
+		queryField{"campus", "Campus", textField},
+		queryField{"title_of_paper", "TitleOfPaper", textField},
+		queryField{"type_of_publication", "TypeOfPublication", textField},
+		queryField{"funding_source", "FundingSource", textField},
+		queryField{"number_of_citation", "NumberOfCitation", intField},
+		queryField{"sdg_no", "SDGNo", textField},
+		queryField{"funding_type", "FundingType", textField},
+		queryField{"nature_of_funding", "NatureOfFunding", textField},
+		queryField{"publisher", "Publisher", textField},
+		queryField{"draft", "Draft", boolField},
+	)
+	userQuery = newQuerySchema("user_id",
+		queryField{"user_id", "UserID", intField},
+		queryField{"sr_code", "SRCode", textField},
+		queryField{"email", "Email", textField},
+		queryField{"account_type", "AccountType", textField},
+		queryField{"user_fname", "UserFname", textField},
+		queryField{"user_lname", "UserLname", textField},
+		queryField{"user_mname", "UserMname", textField},
+	)
+	logQuery = newQuerySchema("log_id",
+		queryField{"log_id", "LogID", textField},
+		queryField{"date_time", "DateTime", timeField},
+		queryField{"user_id", "UserID", intField},
+		queryField{"activity", "Activity", textField},
+		queryField{"method", "Method", textField},
+		queryField{"entity", "Entity", textField},
+		queryField{"entity_id", "EntityID", textField},
+		queryField{"seq", "Seq", intField},
+	)
+)
+
+// isoDateLayouts are the ISO 8601 dates accepted in filters, from the most
+// precise.
+var isoDateLayouts = []string{"2006-01-02", "2006-01", "2006"}
+
+// parseValue converts a filter or page token value to the field's type.
+func (f queryField) parseValue(v string) (any, error) {
+	switch f.kind {
+	case intField:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an integer, got %q", f.name, v)
+		}
+		return n, nil
+	case dateField, timeField:
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return t.UTC(), nil
+		}
+		for _, layout := range isoDateLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("%s must be an ISO 8601 date or an RFC 3339 time, got %q", f.name, v)
+	case boolField:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be true or false, got %q", f.name, v)
+		}
+		return b, nil
+	}
+	return v, nil
+}
+
+// formatValue renders a value of the field for a page token.
+func (f queryField) formatValue(v any) string {
+	if t, ok := v.(time.Time); ok {
+		return t.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(v)
+}
+
+// value reads the field from a row struct for in-memory filtering and
+// ordering. Integers are widened to int64 to match parseValue, and unset
+// dates read as the zero time.
+func (f queryField) value(row reflect.Value) any {
+	v := row.FieldByName(f.goField)
+	switch f.kind {
+	case intField:
+		return v.Int()
+	case boolField:
+		return v.Bool()
+	case dateField, timeField:
+		return timeValue(v.Interface().(*time.Time))
+	}
+	return v.String()
+}
+
+// column is the SQL expression the field is filtered and ordered on. Unset
+// dates are NULL in SQL; they are compared as the zero time, like the memory
+// store does, so that every row has a place in keyset pagination.
+func (f queryField) column() string {
+	switch f.kind {
+	case dateField:
+		return "COALESCE(" + f.name + ", '0001-01-01')"
+	case timeField:
+		return "COALESCE(" + f.name + ", '0001-01-01T00:00:00Z')"
+	}
+	return f.name
+}
+
+// timeValue returns *t, or the zero time for an unset date.
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.UTC()
+}
+
+func compareValues(a, b any) int {
+	switch a := a.(type) {
+	case int64:
+		b := b.(int64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case string:
+		return strings.Compare(a, b.(string))
+	case bool:
+		b := b.(bool)
+		switch {
+		case a == b:
+			return 0
+		case b:
+			return -1
+		}
+		return 1
+	case time.Time:
+		b := b.(time.Time)
+		switch {
+		case a.Before(b):
+			return -1
+		case a.After(b):
+			return 1
+		}
+		return 0
+	}
+	panic(fmt.Sprintf("unsupported filter value %T", a))
+}
+
+// restrictFilter narrows filter to rows whose field equals value.
+func restrictFilter(filter filterExpr, schema *querySchema, field string, value any) filterExpr {
+	c := comparison{field: schema.fields[field], op: "=", value: value}
+	if filter == nil {
+		return c
+	}
+	return logicalExpr{op: "AND", terms: []filterExpr{filter, c}}
+}
+
+// filterExpr is a parsed filter. It can be rendered as a SQL condition or
+// evaluated against a row struct.
+type filterExpr interface {
+	sql(b *strings.Builder, args *[]any)
+	match(row reflect.Value) bool
+}
+
+type logicalExpr struct {
+	op    string // "AND" or "OR"
+	terms []filterExpr
+}
+
+func (e logicalExpr) sql(b *strings.Builder, args *[]any) {
+	b.WriteString("(")
+	for i, t := range e.terms {
+		if i > 0 {
+			b.WriteString(" " + e.op + " ")
+		}
+		t.sql(b, args)
+	}
+	b.WriteString(")")
+}
+
+func (e logicalExpr) match(row reflect.Value) bool {
+	for _, t := range e.terms {
+		if t.match(row) == (e.op == "OR") {
+			return e.op == "OR"
+		}
+	}
+	return e.op == "AND"
+}
+
+type notExpr struct {
+	term filterExpr
+}
+
+func (e notExpr) sql(b *strings.Builder, args *[]any) {
+	b.WriteString("NOT (")
+	e.term.sql(b, args)
+	b.WriteString(")")
+}
+
+func (e notExpr) match(row reflect.Value) bool {
+	return !e.term.match(row)
+}
+
+type comparison struct {
+	field queryField
+	op    string
+	value any
+}
+
+var sqlOperators = map[string]string{
+	"=":  "=",
+	"!=": "<>",
+	"<":  "<",
+	"<=": "<=",
+	">":  ">",
+	">=": ">=",
+}
+
+func (c comparison) sql(b *strings.Builder, args *[]any) {
+	if c.op == ":" {
+		b.WriteString(c.field.column() + ` ILIKE ?`)
+		*args = append(*args, "%"+likeEscaper.Replace(c.value.(string))+"%")
+		return
+	}
+	b.WriteString(c.field.column() + " " + sqlOperators[c.op] + " ?")
+	*args = append(*args, c.value)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (c comparison) match(row reflect.Value) bool {
+	v := c.field.value(row)
+	if c.op == ":" {
+		return strings.Contains(strings.ToLower(v.(string)), strings.ToLower(c.value.(string)))
+	}
+	cmp := compareValues(v, c.value)
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokString
+	tokOperator
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c == '.' || c == '-' || c == '+' ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+func lexFilter(s string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			toks = append(toks, token{tokLParen, "(", i})
+			i++
+		case c == ')':
+			toks = append(toks, token{tokRParen, ")", i})
+			i++
+		case c == '"' || c == '\'':
+			start := i
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(s) {
+					return nil, fmt.Errorf("unterminated string at position %d", start)
+				}
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+					b.WriteByte(s[i])
+					continue
+				}
+				if s[i] == c {
+					i++
+					break
+				}
+				b.WriteByte(s[i])
+			}
+			toks = append(toks, token{tokString, b.String(), start})
+		case c == '<' || c == '>' || c == '!':
+			op := string(c)
+			if i+1 < len(s) && s[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("unexpected '!' at position %d", i)
+			}
+			toks = append(toks, token{tokOperator, op, i})
+			i += len(op)
+		case c == '=' || c == ':':
+			toks = append(toks, token{tokOperator, string(c), i})
+			i++
+		case isWordChar(c):
+			start := i
+			for i < len(s) && isWordChar(s[i]) {
+				i++
+			}
+			toks = append(toks, token{tokWord, s[start:i], start})
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %d", c, i)
+		}
+	}
+	return append(toks, token{tokEOF, "", len(s)}), nil
+}
+
+type filterParser struct {
+	schema *querySchema
+	toks   []token
+	pos    int
+	depth  int
+}
+
+// parseFilter parses a filter string against the entity schema. An empty
+// filter yields a nil expression, which matches every row.
+func parseFilter(filter string, schema *querySchema) (filterExpr, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	if len(filter) > maxFilterLength {
+		return nil, fmt.Errorf("filter is longer than %d characters", maxFilterLength)
+	}
+	toks, err := lexFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{schema: schema, toks: toks}
+	expr, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+	}
+	return expr, nil
+}
+
+func (p *filterParser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *filterParser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *filterParser) keyword(word string) bool {
+	if t := p.peek(); t.kind == tokWord && t.text == word {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) expression() (filterExpr, error) {
+	return p.logical("AND", p.factor)
+}
+
+func (p *filterParser) factor() (filterExpr, error) {
+	return p.logical("OR", p.term)
+}
+
+func (p *filterParser) logical(op string, operand func() (filterExpr, error)) (filterExpr, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	terms := []filterExpr{first}
+	for p.keyword(op) {
+		t, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, t)
+	}
+	if len(terms) == 1 {
+		return first, nil
+	}
+	return logicalExpr{op: op, terms: terms}, nil
+}
+
+func (p *filterParser) term() (filterExpr, error) {
+	if p.keyword("NOT") {
+		t, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{term: t}, nil
+	}
+	if p.peek().kind == tokLParen {
+		open := p.next()
+		if p.depth++; p.depth > maxFilterDepth {
+			return nil, fmt.Errorf("filter nests deeper than %d levels", maxFilterDepth)
+		}
+		expr, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		p.depth--
+		if p.next().kind != tokRParen {
+			return nil, fmt.Errorf("unclosed '(' at position %d", open.pos)
+		}
+		return expr, nil
+	}
+	return p.comparison()
+}
+
+func (p *filterParser) comparison() (filterExpr, error) {
+	name := p.next()
+	if name.kind != tokWord {
+		return nil, fmt.Errorf("expected a field name at position %d", name.pos)
+	}
+	field, ok := p.schema.fields[name.text]
+	if !ok {
+		return nil, fmt.Errorf("cannot filter on %q", name.text)
+	}
+	op := p.next()
+	if op.kind != tokOperator {
+		return nil, fmt.Errorf("expected an operator after %s at position %d", name.text, op.pos)
+	}
+	if op.text == ":" && field.kind != textField {
+		return nil, fmt.Errorf("%s does not support ':'", name.text)
+	}
+	v := p.next()
+	if v.kind != tokWord && v.kind != tokString {
+		return nil, fmt.Errorf("expected a value after %s %s at position %d", name.text, op.text, v.pos)
+	}
+	value, err := field.parseValue(v.text)
+	if err != nil {
+		return nil, err
+	}
+	return comparison{field: field, op: op.text, value: value}, nil
+}
+
+// orderTerm is one key of an order_by clause.
+type orderTerm struct {
+	field queryField
+	desc  bool
+}
+
+// parseOrderBy parses an AIP-132 order_by such as "date_published desc,
+// title_of_paper". The primary key is appended as a final ascending key so
+// the order is total, which keyset pagination relies on.
+func parseOrderBy(orderBy string, schema *querySchema) ([]orderTerm, error) {
+	var terms []orderTerm
+	seen := map[string]bool{}
+	for _, part := range strings.Split(orderBy, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 {
+			if strings.TrimSpace(orderBy) == "" {
+				break
+			}
+			return nil, fmt.Errorf("empty order_by key in %q", orderBy)
+		}
+		field, ok := schema.fields[words[0]]
+		if !ok {
+			return nil, fmt.Errorf("cannot order by %q", words[0])
+		}
+		if seen[field.name] {
+			return nil, fmt.Errorf("%s appears twice in order_by", field.name)
+		}
+		seen[field.name] = true
+
+		term := orderTerm{field: field}
+		switch {
+		case len(words) == 1:
+		case len(words) == 2 && strings.EqualFold(words[1], "asc"):
+		case len(words) == 2 && strings.EqualFold(words[1], "desc"):
+			term.desc = true
+		default:
+			return nil, fmt.Errorf("invalid order_by key %q", strings.TrimSpace(part))
+		}
+		terms = append(terms, term)
+	}
+	if !seen[schema.key] {
+		terms = append(terms, orderTerm{field: schema.fields[schema.key]})
+	}
+	return terms, nil
+}
+
+func orderSQL(terms []orderTerm) string {
+	keys := make([]string, len(terms))
+	for i, t := range terms {
+		keys[i] = t.field.column()
+		if t.desc {
+			keys[i] += " DESC"
+		}
+	}
+	return strings.Join(keys, ", ")
+}
+
+// keysetSQL renders the condition selecting the rows that sort after the
+// given key values, e.g. ((a > ?) OR (a = ? AND b > ?)).
+func keysetSQL(terms []orderTerm, after []any) (string, []any) {
+	var b strings.Builder
+	var args []any
+	b.WriteString("(")
+	for i := range terms {
+		if i > 0 {
+			b.WriteString(" OR ")
+		}
+		b.WriteString("(")
+		for j := 0; j < i; j++ {
+			b.WriteString(terms[j].field.column() + " = ? AND ")
+			args = append(args, after[j])
+		}
+		op := " > ?"
+		if terms[i].desc {
+			op = " < ?"
+		}
+		b.WriteString(terms[i].field.column() + op + ")")
+		args = append(args, after[i])
+	}
+	b.WriteString(")")
+	return b.String(), args
+}
+
+// compareRows orders two rows by the given terms.
+func compareRows(terms []orderTerm, a, b reflect.Value) int {
+	for _, t := range terms {
+		if c := compareValues(t.field.value(a), t.field.value(b)); c != 0 {
+			if t.desc {
+				return -c
+			}
+			return c
+		}
+	}
+	return 0
+}
+
+// afterKey reports whether row sorts after the given key values.
+func afterKey(terms []orderTerm, row reflect.Value, after []any) bool {
+	for i, t := range terms {
+		c := compareValues(t.field.value(row), after[i])
+		if t.desc {
+			c = -c
+		}
+		if c != 0 {
+			return c > 0
+		}
+	}
+	return false
+}
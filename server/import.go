@@ -0,0 +1,399 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var errImportRolledBack = errors.New("import rolled back")
+
+// maxMessageSize is the largest request the server accepts, which bounds the
+// size of imported files.
+const maxMessageSize = 32 << 20
+
+// importDateLayouts are the dates accepted in imported cells: ISO 8601 and
+// the formats spreadsheets display dates in.
+var importDateLayouts = []struct {
+	layout     string
+	month, day bool
+}{
+	{"2006-01-02", true, true},
+	{"2006-01", true, false},
+	{"2006", false, false},
+	{"1/2/2006", true, true},
+	{"January 2, 2006", true, true},
+	{"Jan 2, 2006", true, true},
+	{"January 2 2006", true, true},
+	{"Jan 2 2006", true, true},
+	{"2 January 2006", true, true},
+	{"2 Jan 2006", true, true},
+	{"January 2006", true, false},
+	{"Jan 2006", true, false},
+}
+
+// importEntity describes the records of one entity an import can write.
+type importEntity struct {
+	key    string
+	fields map[string]string
+	record func() proto.Message
+}
+
+var importEntities = map[string]importEntity{
+	entityIPAsset:     {"registration_number", ipAssetMaskFields, func() proto.Message { return &pb.IP_Asset{} }},
+	entityPublication: {"publication_id", publicationMaskFields, func() proto.Message { return &pb.Publication{} }},
+}
+
+// importRow is a non-blank row of an imported file, with its cells by field
+// name. err is set when the row could not be read.
+type importRow struct {
+	line  int
+	cells map[string]string
+	err   error
+}
+
+// columnField resolves a CSV column or JSON key to the field it fills, or ""
+// when it is ignored.
+func (e importEntity) columnField(column string, mapping map[string]string) (string, error) {
+	if field, ok := mapping[column]; ok {
+		return field, nil
+	}
+	name := strings.ToLower(strings.TrimSpace(column))
+	name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+	if _, ok := e.fields[name]; ok || name == e.key {
+		return name, nil
+	}
+	return "", fmt.Errorf("column %q is not a field; map it to one, or to \"\" to ignore it", column)
+}
+
+// readImportRows splits an imported file into rows.
+func readImportRows(format string, data []byte, e importEntity, mapping map[string]string) ([]importRow, error) {
+	switch format {
+	case "csv":
+		return readCSVRows(data, e, mapping)
+	case "jsonl":
+		return readJSONLRows(data, e, mapping)
+	}
+	return nil, invalidArgument("format", fmt.Sprintf("%q is not an import format: want csv or jsonl", format))
+}
+
+func readCSVRows(data []byte, e importEntity, mapping map[string]string) ([]importRow, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		return nil, invalidArgument("data", fmt.Sprintf("reading the CSV header: %v", err))
+	}
+	fields := make([]string, len(header))
+	var v violations
+	for i, column := range header {
+		if fields[i], err = e.columnField(column, mapping); err != nil {
+			v.add("data", err.Error())
+		}
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	var rows []importRow
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := r.FieldPos(0)
+		row := importRow{line: line, cells: map[string]string{}}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, invalidArgument("data", err.Error())
+			}
+			row.line, row.err = parseErr.StartLine, err
+			rows = append(rows, row)
+			continue
+		}
+		if len(record) > len(fields) {
+			row.err = fmt.Errorf("the row has %d cells but the header only %d columns", len(record), len(fields))
+		}
+		for i, cell := range record {
+			if i < len(fields) && fields[i] != "" && strings.TrimSpace(cell) != "" {
+				row.cells[fields[i]] = strings.TrimSpace(cell)
+			}
+		}
+		if len(row.cells) > 0 || row.err != nil {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func readJSONLRows(data []byte, e importEntity, mapping map[string]string) ([]importRow, error) {
+	var rows []importRow
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(nil, len(data)+1)
+	for line := 1; sc.Scan(); line++ {
+		text := bytes.TrimSpace(sc.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		row := importRow{line: line, cells: map[string]string{}}
+		var object map[string]any
+		d := json.NewDecoder(bytes.NewReader(text))
+		d.UseNumber()
+		if err := d.Decode(&object); err != nil {
+			row.err = err
+			rows = append(rows, row)
+			continue
+		}
+		for key, value := range object {
+			field, err := e.columnField(key, mapping)
+			if err != nil {
+				row.err = err
+				break
+			}
+			var cell string
+			switch value := value.(type) {
+			case nil:
+			case string:
+				cell = strings.TrimSpace(value)
+			case json.Number:
+				cell = value.String()
+			default:
+				row.err = fmt.Errorf("key %q must hold a string or a number", key)
+			}
+			if field != "" && cell != "" {
+				row.cells[field] = cell
+			}
+		}
+		if len(row.cells) > 0 || row.err != nil {
+			rows = append(rows, row)
+		}
+	}
+	return rows, sc.Err()
+}
+
+// importRecord builds the record of a row. It returns the fields the row
+// sets besides the key, sorted, which an upsert updates.
+func importRecord(entity string, e importEntity, row importRow) (proto.Message, []string, error) {
+	record := e.record()
+	m := record.ProtoReflect()
+	var names []string
+	for field := range row.cells {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+	var fields []string
+	var v violations
+	for _, field := range names {
+		cell := row.cells[field]
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(field))
+		path := entity + "." + field
+		switch {
+		case fd.Kind() == protoreflect.StringKind:
+			m.Set(fd, protoreflect.ValueOfString(cell))
+		case fd.Kind() == protoreflect.Int32Kind:
+			n, err := strconv.ParseInt(cell, 10, 32)
+			if err != nil {
+				v.add(path, fmt.Sprintf("%q is not a whole number", cell))
+				continue
+			}
+			m.Set(fd, protoreflect.ValueOfInt32(int32(n)))
+		case fd.Kind() == protoreflect.BoolKind:
+			b, err := strconv.ParseBool(cell)
+			if err != nil {
+				v.add(path, fmt.Sprintf("%q is not true or false", cell))
+				continue
+			}
+			m.Set(fd, protoreflect.ValueOfBool(b))
+		case fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() == "google.type.Date":
+			d, ok := parseImportDate(cell)
+			if !ok {
+				v.add(path, fmt.Sprintf("%q is not a date such as 2021-06-30, 2021-06, 2021, 6/30/2021 or June 30, 2021", cell))
+				continue
+			}
+			m.Set(fd, protoreflect.ValueOfMessage(d.ProtoReflect()))
+		default:
+			v.add(path, "cannot be imported")
+			continue
+		}
+		if field != e.key {
+			fields = append(fields, field)
+		}
+	}
+	return record, fields, v.err()
+}
+
+func parseImportDate(cell string) (*date.Date, bool) {
+	for _, l := range importDateLayouts {
+		t, err := time.Parse(l.layout, cell)
+		if err != nil {
+			continue
+		}
+		d := &date.Date{Year: int32(t.Year())}
+		if l.month {
+			d.Month = int32(t.Month())
+		}
+		if l.day {
+			d.Day = int32(t.Day())
+		}
+		return d, true
+	}
+	return nil, false
+}
+
+// keepKeyKey marks the context of a create that keeps the key of the record
+// rather than assigning a new one.
+type keepKeyKey struct{}
+
+// keepsKey reports whether a create keeps the key it was given.
+func keepsKey(ctx context.Context) bool {
+	keep, _ := ctx.Value(keepKeyKey{}).(bool)
+	return keep
+}
+
+// importRecordRow creates the record of a row, keeping the key it holds, or
+// updates the record it names when upsert is set. It returns the action taken
+// and the record's key.
+func (s *server) importRecordRow(ctx context.Context, entity string, record proto.Message, fields []string, upsert bool) (string, string, error) {
+	switch r := record.(type) {
+	case *pb.IP_Asset:
+		if id := r.GetRegistrationNumber(); id != "" {
+			_, err := s.ipAssets.GetIPAsset(ctx, id)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				return actionCreate, id, storeError(err, "IP_asset", id)
+			}
+			if err == nil {
+				if !upsert {
+					return actionCreate, id, status.Errorf(codes.AlreadyExists, "IP_asset %s already exists; import with upsert to update it", id)
+				}
+				if len(fields) == 0 {
+					return actionSkip, id, nil
+				}
+				_, err := s.call(ctx, "UpdateIP_Asset", &pb.UpdateIP_AssetRequest{IpAsset: r, UpdateMask: &fieldmaskpb.FieldMask{Paths: fields}},
+					func(ctx context.Context, req any) (any, error) {
+						return s.UpdateIP_Asset(ctx, req.(*pb.UpdateIP_AssetRequest))
+					})
+				return actionUpdate, id, err
+			}
+		}
+		res, err := s.call(context.WithValue(ctx, keepKeyKey{}, true), "CreateIP_Asset", &pb.CreateIP_AssetRequest{IpAsset: r},
+			func(ctx context.Context, req any) (any, error) {
+				return s.CreateIP_Asset(ctx, req.(*pb.CreateIP_AssetRequest))
+			})
+		if err != nil {
+			return actionCreate, "", err
+		}
+		return actionCreate, res.(*pb.CreateIP_AssetResponse).GetIpAsset().GetRegistrationNumber(), nil
+	case *pb.Publication:
+		if id := r.GetPublicationId(); id != "" {
+			_, err := s.publications.GetPublication(ctx, id)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				return actionCreate, id, storeError(err, "publication", id)
+			}
+			if err == nil {
+				if !upsert {
+					return actionCreate, id, status.Errorf(codes.AlreadyExists, "publication %s already exists; import with upsert to update it", id)
+				}
+				if len(fields) == 0 {
+					return actionSkip, id, nil
+				}
+				_, err := s.call(ctx, "UpdatePublication", &pb.UpdatePublicationRequest{Publication: r, UpdateMask: &fieldmaskpb.FieldMask{Paths: fields}},
+					func(ctx context.Context, req any) (any, error) {
+						return s.UpdatePublication(ctx, req.(*pb.UpdatePublicationRequest))
+					})
+				return actionUpdate, id, err
+			}
+		}
+		res, err := s.call(context.WithValue(ctx, keepKeyKey{}, true), "CreatePublication", &pb.CreatePublicationRequest{Publication: r},
+			func(ctx context.Context, req any) (any, error) {
+				return s.CreatePublication(ctx, req.(*pb.CreatePublicationRequest))
+			})
+		if err != nil {
+			return actionCreate, "", err
+		}
+		return actionCreate, res.(*pb.CreatePublicationResponse).GetPublication().GetPublicationId(), nil
+	}
+	return "", "", status.Errorf(codes.InvalidArgument, "%s records cannot be imported", entity)
+}
+
+// ImportRecords runs the rows of a file in one transaction, which it rolls
+// back instead of committing for a dry run or a failed all-or-nothing
+// import, so that the report of a dry run matches what the import would do.
+// Each row runs in a transaction of its own within it, so that a failed row
+// rolls back only its own writes and the rows after it still run.
+func (s *server) ImportRecords(ctx context.Context, req *pb.ImportRecordsRequest) (*pb.ImportRecordsResponse, error) {
+	fmt.Println("Import Records", req.GetEntity(), req.GetFormat())
+	e := importEntities[req.GetEntity()]
+	var v violations
+	for column, field := range req.GetColumnMapping() {
+		if _, ok := e.fields[field]; !ok && field != "" && field != e.key {
+			v.add(fmt.Sprintf("column_mapping[%q]", column), fmt.Sprintf("%q is not a field of %s", field, req.GetEntity()))
+		}
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	rows, err := readImportRows(req.GetFormat(), req.GetData(), e, req.GetColumnMapping())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ImportRecordsResponse{Results: []*pb.ImportRowResult{}}
+	err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		for _, row := range rows {
+			result := &pb.ImportRowResult{Row: int32(row.line)}
+			err := row.err
+			if err != nil {
+				err = status.Error(codes.InvalidArgument, err.Error())
+			} else {
+				var record proto.Message
+				var fields []string
+				record, fields, err = importRecord(req.GetEntity(), e, row)
+				if err == nil {
+					err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+						var err error
+						result.Action, result.Id, err = s.importRecordRow(ctx, req.GetEntity(), record, fields, req.GetUpsert())
+						return err
+					})
+				}
+			}
+			result.Status = status.Convert(err).Proto()
+			switch {
+			case err != nil:
+				res.Failed++
+			case result.Action == actionCreate:
+				res.Created++
+			case result.Action == actionUpdate:
+				res.Updated++
+			}
+			res.Results = append(res.Results, result)
+		}
+		if req.GetDryRun() || (req.GetMode() == pb.BatchMode_BATCH_MODE_ALL_OR_NOTHING && res.Failed > 0) {
+			return errImportRolledBack
+		}
+		return nil
+	})
+	if errors.Is(err, errImportRolledBack) {
+		res.RolledBack = true
+	} else if err != nil {
+		return nil, storeError(err, req.GetEntity(), "")
+	}
+	return res, nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const importCSV = "Title of Paper,Date Published,Quartile\n" +
+	"Deep Learning for Rice Yield,2021-03-04,Q1\n" +
+	"Mangrove Carbon Stocks,not a date,Q2\n" +
+	"Coral Reef Monitoring,2022,Q3\n"
+
+func importPublications(t *testing.T, ts *testServer, req *pb.ImportRecordsRequest) *pb.ImportRecordsResponse {
+	t.Helper()
+	req.Entity = entityPublication
+	req.Format = "csv"
+	if req.Data == nil {
+		req.Data = []byte(importCSV)
+	}
+	res, err := call(ts.as(roleAdmin), ts, "ImportRecords", (*server).ImportRecords, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func countPublications(t *testing.T, ts *testServer) int64 {
+	t.Helper()
+	n, err := ts.store.CountPublications(context.Background(), ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestImportBestEffort(t *testing.T) {
+	ts := newTestServer(t)
+	res := importPublications(t, ts, &pb.ImportRecordsRequest{Mode: pb.BatchMode_BATCH_MODE_BEST_EFFORT})
+	if res.GetCreated() != 2 || res.GetFailed() != 1 || res.GetRolledBack() {
+		t.Fatalf("created %d, failed %d, rolled back %v", res.GetCreated(), res.GetFailed(), res.GetRolledBack())
+	}
+	failed := res.GetResults()[1]
+	if failed.GetRow() != 3 || status.FromProto(failed.GetStatus()).Code() != codes.InvalidArgument {
+		t.Errorf("result of the bad row %v", failed)
+	}
+	if n := countPublications(t, ts); n != 2 {
+		t.Errorf("imported %d publications, want 2", n)
+	}
+}
+
+func TestImportAllOrNothing(t *testing.T) {
+	ts := newTestServer(t)
+	res := importPublications(t, ts, &pb.ImportRecordsRequest{Mode: pb.BatchMode_BATCH_MODE_ALL_OR_NOTHING})
+	if !res.GetRolledBack() || res.GetFailed() != 1 {
+		t.Fatalf("failed %d, rolled back %v", res.GetFailed(), res.GetRolledBack())
+	}
+	if n := countPublications(t, ts); n != 0 {
+		t.Errorf("a failed all-or-nothing import left %d publications", n)
+	}
+}
+
+func TestImportDryRun(t *testing.T) {
+	ts := newTestServer(t)
+	res := importPublications(t, ts, &pb.ImportRecordsRequest{DryRun: true, Mode: pb.BatchMode_BATCH_MODE_BEST_EFFORT})
+	if !res.GetRolledBack() || res.GetCreated() != 2 {
+		t.Fatalf("created %d, rolled back %v", res.GetCreated(), res.GetRolledBack())
+	}
+	if n := countPublications(t, ts); n != 0 {
+		t.Errorf("a dry run left %d publications", n)
+	}
+}
+
+func TestImportUpsert(t *testing.T) {
+	ts := newTestServer(t)
+	created, err := call(ts.as(roleAdmin), ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield", Publisher: "Elsevier"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetPublication().GetPublicationId()
+	data := []byte("publication_id,quartile\n" + id + ",Q2\n")
+
+	res := importPublications(t, ts, &pb.ImportRecordsRequest{Data: data, Mode: pb.BatchMode_BATCH_MODE_BEST_EFFORT})
+	wantCode(t, status.ErrorProto(res.GetResults()[0].GetStatus()), codes.AlreadyExists)
+
+	res = importPublications(t, ts, &pb.ImportRecordsRequest{Data: data, Upsert: true, Mode: pb.BatchMode_BATCH_MODE_BEST_EFFORT})
+	if res.GetUpdated() != 1 || res.GetResults()[0].GetId() != id {
+		t.Fatalf("upsert results %v", res.GetResults())
+	}
+	got, err := ts.store.GetPublication(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Quartile != "Q2" || got.Publisher != "Elsevier" {
+		t.Errorf("upserted quartile %q, publisher %q", got.Quartile, got.Publisher)
+	}
+}
+
+func TestImportKeepsKeys(t *testing.T) {
+	ts := newTestServer(t)
+	data := []byte("publication_id,title_of_paper\nPUB-2021-001,Deep Learning for Rice Yield\n")
+	res := importPublications(t, ts, &pb.ImportRecordsRequest{Data: data, Mode: pb.BatchMode_BATCH_MODE_BEST_EFFORT})
+	if res.GetCreated() != 1 || res.GetResults()[0].GetId() != "PUB-2021-001" {
+		t.Fatalf("import results %v", res.GetResults())
+	}
+	got, err := ts.store.GetPublication(context.Background(), "PUB-2021-001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.TitleOfPaper != "Deep Learning for Rice Yield" {
+		t.Errorf("imported title %q", got.TitleOfPaper)
+	}
+
+	created, err := call(ts.as(roleAdmin), ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{PublicationId: "PUB-2021-002", TitleOfPaper: "Mangrove Carbon Stocks"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetPublication().GetPublicationId() == "PUB-2021-002" {
+		t.Error("CreatePublication kept the key it was given")
+	}
+}
+
+// unreachablePublicationStore is a memory store that cannot look up
+// publications.
+type unreachablePublicationStore struct {
+	*memoryStore
+}
+
+func (unreachablePublicationStore) GetPublication(ctx context.Context, id string) (*Publication, error) {
+	return nil, errors.New("connection reset")
+}
+
+func TestImportReportsLookupErrors(t *testing.T) {
+	ts := newTestServer(t)
+	ts.publications = unreachablePublicationStore{ts.store}
+	data := []byte("publication_id,title_of_paper\nPUB-2021-001,Deep Learning for Rice Yield\n")
+	res := importPublications(t, ts, &pb.ImportRecordsRequest{Data: data, Mode: pb.BatchMode_BATCH_MODE_BEST_EFFORT})
+	if res.GetFailed() != 1 || status.FromProto(res.GetResults()[0].GetStatus()).Code() != codes.Internal {
+		t.Fatalf("import results %v", res.GetResults())
+	}
+	if n := countPublications(t, ts); n != 0 {
+		t.Errorf("a failed lookup created %d publications", n)
+	}
+}
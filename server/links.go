@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pb "example.com/go-grpc-crud-api/proto"
+)
+
+// recordKind names the kinds of records authors can be linked to.
+type recordKind string
+
+const (
+	kindPublication recordKind = "publication"
+	kindIPAsset     recordKind = "ip_asset"
+)
+
+const (
+	roleMainAuthor = "main_author"
+	roleCoAuthor   = "co_author"
+	roleInventor   = "inventor"
+)
+
+// AuthorLink ties an author to a publication or IP asset. RecordID is the
+// publication ID or registration number.
+type AuthorLink struct {
+	RecordID    string
+	AuthorID    string
+	AuthorOrder int32
+	Role        string
+}
+
+// LinkedAuthor is an author as listed on a record.
+type LinkedAuthor struct {
+	Author      Author `gorm:"embedded"`
+	RecordID    string
+	AuthorOrder int32
+	Role        string
+}
+
+func newAuthorLink(kind recordKind, recordID, authorID string, order int32, role string) (AuthorLink, error) {
+	if recordID == "" {
+		return AuthorLink{}, invalidArgument("record_id", "is required")
+	}
+	if authorID == "" {
+		return AuthorLink{}, invalidArgument("author_id", "is required")
+	}
+	if order < 0 {
+		return AuthorLink{}, invalidArgument("author_order", "must not be negative")
+	}
+	switch role {
+	case "":
+		role = roleCoAuthor
+		if kind == kindIPAsset {
+			role = roleInventor
+		}
+	case roleMainAuthor, roleCoAuthor, roleInventor:
+	default:
+		return AuthorLink{}, invalidArgument("role", fmt.Sprintf("must be %s, %s or %s", roleMainAuthor, roleCoAuthor, roleInventor))
+	}
+	return AuthorLink{RecordID: recordID, AuthorID: authorID, AuthorOrder: order, Role: role}, nil
+}
+
+func linkedAuthorsToProto(links []LinkedAuthor) []*pb.LinkedAuthor {
+	authors := []*pb.LinkedAuthor{}
+	for i := range links {
+		authors = append(authors, &pb.LinkedAuthor{
+			Author:      authorToProto(&links[i].Author),
+			AuthorOrder: links[i].AuthorOrder,
+			Role:        links[i].Role,
+		})
+	}
+	return authors
+}
+
+// withPublicationAuthors fills in the linked authors of the publications.
+func (s *server) withPublicationAuthors(ctx context.Context, publications ...*pb.Publication) error {
+	ids := make([]string, len(publications))
+	for i, p := range publications {
+		ids[i] = p.PublicationId
+	}
+	links, err := s.links.LinkedAuthors(ctx, kindPublication, ids)
+	if err != nil {
+		return storeError(err, "author link", "")
+	}
+	for _, p := range publications {
+		p.LinkedAuthors = linkedAuthorsToProto(links[p.PublicationId])
+	}
+	return nil
+}
+
+// withIPAssetAuthors fills in the linked authors of the IP assets.
+func (s *server) withIPAssetAuthors(ctx context.Context, ipAssets ...*pb.IP_Asset) error {
+	ids := make([]string, len(ipAssets))
+	for i, a := range ipAssets {
+		ids[i] = a.RegistrationNumber
+	}
+	links, err := s.links.LinkedAuthors(ctx, kindIPAsset, ids)
+	if err != nil {
+		return storeError(err, "author link", "")
+	}
+	for _, a := range ipAssets {
+		a.LinkedAuthors = linkedAuthorsToProto(links[a.RegistrationNumber])
+	}
+	return nil
+}
+
+func (s *server) LinkPublicationAuthor(ctx context.Context, req *pb.LinkPublicationAuthorRequest) (*pb.LinkPublicationAuthorResponse, error) {
+	fmt.Println("Link Publication Author", req.GetPublicationId(), req.GetAuthorId())
+	link, err := newAuthorLink(kindPublication, req.GetPublicationId(), req.GetAuthorId(), req.GetAuthorOrder(), req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.links.LinkAuthor(ctx, kindPublication, link); err != nil {
+		return nil, storeError(err, "publication or author", req.GetPublicationId()+", "+req.GetAuthorId())
+	}
+
+	publication, err := s.publications.GetPublication(ctx, req.GetPublicationId())
+	if err != nil {
+		return nil, storeError(err, "publication", req.GetPublicationId())
+	}
+	res := publicationToProto(publication)
+	if err := s.withPublicationAuthors(ctx, res); err != nil {
+		return nil, err
+	}
+	return &pb.LinkPublicationAuthorResponse{
+		Publication: res,
+	}, nil
+}
+
+func (s *server) UnlinkPublicationAuthor(ctx context.Context, req *pb.UnlinkPublicationAuthorRequest) (*pb.UnlinkPublicationAuthorResponse, error) {
+	fmt.Println("Unlink Publication Author", req.GetPublicationId(), req.GetAuthorId())
+	if err := s.links.UnlinkAuthor(ctx, kindPublication, req.GetPublicationId(), req.GetAuthorId()); err != nil {
+		return nil, storeError(err, "author link", req.GetPublicationId()+"/"+req.GetAuthorId())
+	}
+
+	return &pb.UnlinkPublicationAuthorResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *server) LinkIP_AssetAuthor(ctx context.Context, req *pb.LinkIP_AssetAuthorRequest) (*pb.LinkIP_AssetAuthorResponse, error) {
+	fmt.Println("Link IP_asset Author", req.GetRegistrationNumber(), req.GetAuthorId())
+	link, err := newAuthorLink(kindIPAsset, req.GetRegistrationNumber(), req.GetAuthorId(), req.GetAuthorOrder(), req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.links.LinkAuthor(ctx, kindIPAsset, link); err != nil {
+		return nil, storeError(err, "IP_asset or author", req.GetRegistrationNumber()+", "+req.GetAuthorId())
+	}
+
+	ipAsset, err := s.ipAssets.GetIPAsset(ctx, req.GetRegistrationNumber())
+	if err != nil {
+		return nil, storeError(err, "IP_asset", req.GetRegistrationNumber())
+	}
+	res := ipAssetToProto(ipAsset)
+	if err := s.withIPAssetAuthors(ctx, res); err != nil {
+		return nil, err
+	}
+	return &pb.LinkIP_AssetAuthorResponse{
+		IpAsset: res,
+	}, nil
+}
+
+func (s *server) UnlinkIP_AssetAuthor(ctx context.Context, req *pb.UnlinkIP_AssetAuthorRequest) (*pb.UnlinkIP_AssetAuthorResponse, error) {
+	fmt.Println("Unlink IP_asset Author", req.GetRegistrationNumber(), req.GetAuthorId())
+	if err := s.links.UnlinkAuthor(ctx, kindIPAsset, req.GetRegistrationNumber(), req.GetAuthorId()); err != nil {
+		return nil, storeError(err, "author link", req.GetRegistrationNumber()+"/"+req.GetAuthorId())
+	}
+
+	return &pb.UnlinkIP_AssetAuthorResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *server) ListAuthorPublications(ctx context.Context, req *pb.ListAuthorPublicationsRequest) (*pb.ListAuthorPublicationsResponse, error) {
+	fmt.Println("List Author Publications", req.GetAuthorId())
+	if _, err := s.authors.GetAuthor(ctx, req.GetAuthorId()); err != nil {
+		return nil, storeError(err, "author", req.GetAuthorId())
+	}
+	list, err := s.links.AuthorPublications(ctx, req.GetAuthorId())
+	if err != nil {
+		return nil, storeError(err, "publication", "")
+	}
+	publications := []*pb.Publication{}
+	for i := range list {
+		publications = append(publications, publicationToProto(&list[i]))
+	}
+	if err := s.withPublicationAuthors(ctx, publications...); err != nil {
+		return nil, err
+	}
+
+	return &pb.ListAuthorPublicationsResponse{
+		Publications: publications,
+	}, nil
+}
+
+func (s *server) ListAuthorIP_Assets(ctx context.Context, req *pb.ListAuthorIP_AssetsRequest) (*pb.ListAuthorIP_AssetsResponse, error) {
+	fmt.Println("List Author IP_assets", req.GetAuthorId())
+	if _, err := s.authors.GetAuthor(ctx, req.GetAuthorId()); err != nil {
+		return nil, storeError(err, "author", req.GetAuthorId())
+	}
+	list, err := s.links.AuthorIPAssets(ctx, req.GetAuthorId())
+	if err != nil {
+		return nil, storeError(err, "IP_asset", "")
+	}
+	ipAssets := []*pb.IP_Asset{}
+	for i := range list {
+		ipAssets = append(ipAssets, ipAssetToProto(&list[i]))
+	}
+	if err := s.withIPAssetAuthors(ctx, ipAssets...); err != nil {
+		return nil, err
+	}
+
+	return &pb.ListAuthorIP_AssetsResponse{
+		IpAssets: ipAssets,
+	}, nil
+}
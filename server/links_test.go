@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+)
+
+func TestLinkPublicationAuthors(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	publication, err := ts.CreatePublication(ctx, &pb.CreatePublicationRequest{Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicationID := publication.GetPublication().GetPublicationId()
+	var authorIDs []string
+	for _, name := range []string{"Juan Dela Cruz", "Maria Santos"} {
+		author, err := ts.CreateAuthor(ctx, &pb.CreateAuthorRequest{Author: &pb.Author{AuthorName: name}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		authorIDs = append(authorIDs, author.GetAuthor().GetAuthorId())
+	}
+
+	if _, err := ts.LinkPublicationAuthor(ctx, &pb.LinkPublicationAuthorRequest{PublicationId: publicationID, AuthorId: authorIDs[1], AuthorOrder: 2}); err != nil {
+		t.Fatal(err)
+	}
+	linked, err := ts.LinkPublicationAuthor(ctx, &pb.LinkPublicationAuthorRequest{PublicationId: publicationID, AuthorId: authorIDs[0], AuthorOrder: 1, Role: roleMainAuthor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	authors := linked.GetPublication().GetLinkedAuthors()
+	if len(authors) != 2 || authors[0].GetAuthor().GetAuthorName() != "Juan Dela Cruz" || authors[0].GetRole() != roleMainAuthor || authors[1].GetRole() != roleCoAuthor {
+		t.Fatalf("linked authors %v, want them in author order", authors)
+	}
+
+	listed, err := ts.ListAuthorPublications(ctx, &pb.ListAuthorPublicationsRequest{AuthorId: authorIDs[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed.GetPublications()) != 1 || listed.GetPublications()[0].GetPublicationId() != publicationID {
+		t.Fatalf("publications of the co-author %v", listed.GetPublications())
+	}
+
+	if _, err := ts.UnlinkPublicationAuthor(ctx, &pb.UnlinkPublicationAuthorRequest{PublicationId: publicationID, AuthorId: authorIDs[1]}); err != nil {
+		t.Fatal(err)
+	}
+	listed, err = ts.ListAuthorPublications(ctx, &pb.ListAuthorPublicationsRequest{AuthorId: authorIDs[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed.GetPublications()) != 0 {
+		t.Errorf("an unlinked author still lists %v", listed.GetPublications())
+	}
+
+	if _, err := ts.LinkPublicationAuthor(ctx, &pb.LinkPublicationAuthorRequest{PublicationId: publicationID, AuthorId: "missing"}); err == nil {
+		t.Error("linked an author that does not exist")
+	}
+	if _, err := ts.LinkPublicationAuthor(ctx, &pb.LinkPublicationAuthorRequest{PublicationId: publicationID, AuthorId: authorIDs[1], Role: "editor"}); err == nil {
+		t.Error("linked an author with an unknown role")
+	}
+}
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Log entries form a hash chain: entry n stores the hash of entry n-1 and a
+// hash over its own contents including that previous hash, so editing,
+// inserting or deleting an entry breaks every hash after it. Deleting the
+// newest entries is caught by comparing the chain against a signed
+// checkpoint of an earlier head.
+
+const verifyBatchSize = 500
+
+// logTime returns the current time as log entries store it: in UTC and to
+// the second, which is all logHash covers.
+func logTime() *time.Time {
+	t := time.Now().UTC().Truncate(time.Second)
+	return &t
+}
+
+// logHash hashes the contents of a log entry. Each field is written as its
+// byte length, a colon, the field and a newline. Migration 0005 computes the
+// same hash in SQL for entries written before the chain existed.
+//
+// The time is hashed as RFC 3339 text in UTC. Entries whose time was stored
+// as other text before migration 0009 typed it keep that text in
+// DateTimeText, which is hashed instead.
+func logHash(l *Log) string {
+	dateTime := l.DateTimeText
+	if dateTime == "" && l.DateTime != nil {
+		dateTime = l.DateTime.UTC().Format(time.RFC3339)
+	}
+	h := sha256.New()
+	fields := []string{
+		strconv.FormatInt(l.Seq, 10), l.PrevHash, l.LogID, dateTime, strconv.Itoa(int(l.UserID)), l.Activity,
+		l.Description, l.Method, l.Entity, l.EntityID, l.Changes,
+	}
+	for _, f := range fields {
+		fmt.Fprintf(h, "%d:%s\n", len(f), f)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// chainLog appends l to the chain whose newest entry is head. head is the
+// zero Log when the chain is empty.
+func chainLog(l, head *Log) {
+	l.Seq = head.Seq + 1
+	l.PrevHash = head.Hash
+	l.Hash = logHash(l)
+}
+
+// chainMismatch explains why row cannot follow prev in the chain, or returns
+// "" when it can.
+func chainMismatch(prev, row *Log) string {
+	switch {
+	case row.Seq != prev.Seq+1:
+		return fmt.Sprintf("expected seq %d, found %d", prev.Seq+1, row.Seq)
+	case row.PrevHash != prev.Hash:
+		return "previous hash does not match the preceding entry"
+	case row.Hash != logHash(row):
+		return "hash does not match the entry contents"
+	}
+	return ""
+}
+
+// verifyLogChain walks the whole chain in seq order and stops at the first
+// break. When checkpoint is given, the chain must still contain its head.
+func verifyLogChain(ctx context.Context, logs LogStore, checkpoint *pb.LogCheckpoint) (*pb.VerifyLogChainResponse, error) {
+	orderBy, err := parseOrderBy("seq", logQuery)
+	if err != nil {
+		return nil, err
+	}
+	opts := ListOptions{OrderBy: orderBy, Limit: verifyBatchSize}
+	res := &pb.VerifyLogChainResponse{}
+	var prev Log
+	for {
+		rows, err := logs.ListLogs(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			row := &rows[i]
+			reason := chainMismatch(&prev, row)
+			if reason == "" && checkpoint != nil && row.Seq == checkpoint.GetSeq() && row.Hash != checkpoint.GetHash() {
+				reason = "hash does not match the checkpoint"
+			}
+			if reason != "" {
+				res.FirstBreak = &pb.LogChainBreak{Seq: row.Seq, LogId: row.LogID, Reason: reason}
+				return res, nil
+			}
+			res.RowsChecked++
+			res.HeadSeq, res.HeadHash = row.Seq, row.Hash
+			prev = *row
+		}
+		if len(rows) < verifyBatchSize {
+			break
+		}
+		opts.After = []any{prev.Seq, prev.LogID}
+	}
+	if checkpoint != nil && res.HeadSeq < checkpoint.GetSeq() {
+		res.FirstBreak = &pb.LogChainBreak{
+			Seq:    res.HeadSeq + 1,
+			Reason: fmt.Sprintf("chain ends at seq %d, before the checkpoint at seq %d", res.HeadSeq, checkpoint.GetSeq()),
+		}
+		return res, nil
+	}
+	res.Intact = true
+	return res, nil
+}
+
+// checkpointKey decodes the configured checkpoint signing key; it returns nil
+// when none is configured.
+func checkpointKey(cfg AuditConfig) (ed25519.PrivateKey, error) {
+	if cfg.CheckpointKey == "" {
+		return nil, nil
+	}
+	seed, err := base64.StdEncoding.DecodeString(cfg.CheckpointKey)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("checkpoint key must be a base64 %d-byte Ed25519 seed", ed25519.SeedSize)
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+func checkpointMessage(c *pb.LogCheckpoint) []byte {
+	return []byte(fmt.Sprintf("rms-log-checkpoint\n%d\n%s\n%s\n", c.GetSeq(), c.GetHash(), c.GetCreatedAt()))
+}
+
+// exportCheckpoint signs the current head of the chain.
+func exportCheckpoint(ctx context.Context, logs LogStore, key ed25519.PrivateKey) (*pb.LogCheckpoint, error) {
+	orderBy, err := parseOrderBy("seq desc", logQuery)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := logs.ListLogs(ctx, ListOptions{OrderBy: orderBy, Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	c := &pb.LogCheckpoint{CreatedAt: time.Now().UTC().Format(time.RFC3339)}
+	if len(rows) > 0 {
+		c.Seq, c.Hash = rows[0].Seq, rows[0].Hash
+	}
+	c.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(key, checkpointMessage(c)))
+	c.PublicKey = base64.StdEncoding.EncodeToString(key.Public().(ed25519.PublicKey))
+	return c, nil
+}
+
+// checkCheckpoint verifies that c was signed with key.
+func checkCheckpoint(c *pb.LogCheckpoint, key ed25519.PrivateKey) error {
+	sig, err := base64.StdEncoding.DecodeString(c.GetSignature())
+	if err != nil || !ed25519.Verify(key.Public().(ed25519.PublicKey), checkpointMessage(c), sig) {
+		return errors.New("checkpoint signature is not valid for this server's checkpoint key")
+	}
+	return nil
+}
+
+func (s *server) VerifyLogChain(ctx context.Context, req *pb.VerifyLogChainRequest) (*pb.VerifyLogChainResponse, error) {
+	fmt.Println("Verify Log Chain")
+	if req.GetCheckpoint() != nil {
+		if s.checkpointKey == nil {
+			return nil, status.Error(codes.FailedPrecondition, "no checkpoint key is configured")
+		}
+		if err := checkCheckpoint(req.GetCheckpoint(), s.checkpointKey); err != nil {
+			return nil, invalidArgument("checkpoint", err.Error())
+		}
+	}
+	res, err := verifyLogChain(ctx, s.logs, req.GetCheckpoint())
+	if err != nil {
+		return nil, storeError(err, "log", "")
+	}
+	return res, nil
+}
+
+func (s *server) ExportLogCheckpoint(ctx context.Context, req *pb.ExportLogCheckpointRequest) (*pb.ExportLogCheckpointResponse, error) {
+	fmt.Println("Export Log Checkpoint")
+	if s.checkpointKey == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no checkpoint key is configured")
+	}
+	c, err := exportCheckpoint(ctx, s.logs, s.checkpointKey)
+	if err != nil {
+		return nil, storeError(err, "log", "")
+	}
+	return &pb.ExportLogCheckpointResponse{
+		Checkpoint: c,
+	}, nil
+}
+
+// runLogChain implements the "logchain verify|checkpoint|keygen" subcommand.
+func runLogChain(cfg Config, args []string, w io.Writer) error {
+	if len(args) == 0 || len(args) > 2 {
+		return errors.New("usage: server [flags] logchain verify [checkpoint.json] | checkpoint | keygen")
+	}
+	if args[0] == "keygen" {
+		seed := make([]byte, ed25519.SeedSize)
+		if _, err := rand.Read(seed); err != nil {
+			return err
+		}
+		key := ed25519.NewKeyFromSeed(seed)
+		fmt.Fprintf(w, "checkpoint_key: %s\n", base64.StdEncoding.EncodeToString(seed))
+		fmt.Fprintf(w, "public_key:     %s\n", base64.StdEncoding.EncodeToString(key.Public().(ed25519.PublicKey)))
+		return nil
+	}
+
+	key, err := checkpointKey(cfg.Audit)
+	if err != nil {
+		return err
+	}
+	// Verifying the chain only reads the log, so the schema is left as it is.
+	store, err := newStore(*storeKind, cfg, false)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "verify":
+		var checkpoint *pb.LogCheckpoint
+		if len(args) == 2 {
+			if key == nil {
+				return errors.New("verifying a checkpoint needs the checkpoint key")
+			}
+			data, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			checkpoint = &pb.LogCheckpoint{}
+			if err := protojson.Unmarshal(data, checkpoint); err != nil {
+				return fmt.Errorf("parsing checkpoint: %w", err)
+			}
+			if err := checkCheckpoint(checkpoint, key); err != nil {
+				return err
+			}
+		}
+		res, err := verifyLogChain(ctx, store, checkpoint)
+		if err != nil {
+			return err
+		}
+		if b := res.GetFirstBreak(); b != nil {
+			return fmt.Errorf("log chain broken at seq %d (log %q): %s; %d entries verified before it", b.Seq, b.LogId, b.Reason, res.RowsChecked)
+		}
+		fmt.Fprintf(w, "Log chain intact: %d entries, head seq %d hash %s\n", res.RowsChecked, res.HeadSeq, res.HeadHash)
+		return nil
+	case "checkpoint":
+		if key == nil {
+			return errors.New("no checkpoint key is configured")
+		}
+		c, err := exportCheckpoint(ctx, store, key)
+		if err != nil {
+			return err
+		}
+		data, err := protojson.MarshalOptions{UseProtoNames: true, Multiline: true}.Marshal(c)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(data))
+		return nil
+	}
+	return fmt.Errorf("unknown logchain command %q: want verify, checkpoint or keygen", args[0])
+}
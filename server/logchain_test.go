@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+)
+
+func TestVerifyLogChain(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as(roleAdmin)
+	for _, name := range []string{"Juan Dela Cruz", "Maria Santos", "Jose Rizal"} {
+		if _, err := call(ctx, ts, "CreateAuthor", (*server).CreateAuthor, &pb.CreateAuthorRequest{Author: &pb.Author{AuthorName: name}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	verified, err := call(ctx, ts, "VerifyLogChain", (*server).VerifyLogChain, &pb.VerifyLogChainRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verified.GetIntact() || verified.GetRowsChecked() != 3 || verified.GetHeadSeq() != 3 {
+		t.Fatalf("verified %v", verified)
+	}
+
+	for id, l := range ts.store.logs {
+		if l.Seq == 2 {
+			l.Description = "read author"
+			ts.store.logs[id] = l
+		}
+	}
+	verified, err = call(ctx, ts, "VerifyLogChain", (*server).VerifyLogChain, &pb.VerifyLogChainRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified.GetIntact() || verified.GetFirstBreak().GetSeq() != 2 {
+		t.Fatalf("verified an edited chain as %v", verified)
+	}
+}
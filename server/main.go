@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"strings"
 	"time"
 
 	pb "example.com/go-grpc-crud-api/proto"
@@ -18,22 +21,17 @@ import (
 	"gorm.io/gorm"
 )
 
-func init() {
-	DatabaseConnection()
-}
-
-var DB *gorm.DB
-var err error
-
 type Author struct {
-	ID           string `gorm:"primarykey"`
+	ID           string `gorm:"column:author_id;primarykey"`
 	AuthorName   string
-	AuthorGender string
-	TypeofAuthor string
+	AuthorGender string `gorm:"column:gender"`
+	TypeofAuthor string `gorm:"column:type_of_author"`
 	Affiliation  string
-	AuthorEmail  string
+	AuthorEmail  string    `gorm:"column:email"`
+	Version      int64     `gorm:"default:1"`
 	CreatedAt    time.Time `gorm:"autoCreateTime:true"`
 	UpdatedAt    time.Time `gorm:"autoUpdateTime:true"`
+	DeletedAt    gorm.DeletedAt
 }
 
 type IP_Asset struct {
@@ -41,8 +39,8 @@ type IP_Asset struct {
 	TitleOfWork        string
 	TypeOfDocument     string
 	ClassOfWork        string
-	DateOfCreation     string
-	DateRegistered     string
+	DateOfCreation     *time.Time `gorm:"type:date"`
+	DateRegistered     *time.Time `gorm:"type:date"`
 	Campus             string
 	College            string
 	Program            string
@@ -50,13 +48,19 @@ type IP_Asset struct {
 	Hyperlink          string
 	Status             string
 	Certificate        string
-	CreatedAt          time.Time `gorm:"autoCreateTime:true"`
-	UpdatedAt          time.Time `gorm:"autoUpdateTime:true"`
+	// The precisions of the dates: precisionYear, precisionMonth or
+	// precisionDay.
+	DateOfCreationPrecision string    `gorm:"default:day"`
+	DateRegisteredPrecision string    `gorm:"default:day"`
+	Version                 int64     `gorm:"default:1"`
+	CreatedAt               time.Time `gorm:"autoCreateTime:true"`
+	UpdatedAt               time.Time `gorm:"autoUpdateTime:true"`
+	DeletedAt               gorm.DeletedAt
 }
 
 type Publication struct {
-	PublicationID        string `gorm:"primarykey"`
-	DatePublished        string
+	PublicationID        string     `gorm:"primarykey"`
+	DatePublished        *time.Time `gorm:"type:date"`
 	Quartile             string
 	Authors              string
 	Department           string
@@ -72,8 +76,14 @@ type Publication struct {
 	NatureOfFunding      string
 	Publisher            string
 	Abstract             string
-	CreatedAt            time.Time `gorm:"autoCreateTime:true"`
-	UpdatedAt            time.Time `gorm:"autoUpdateTime:true"`
+	Draft                bool
+	// The precision of DatePublished: precisionYear, precisionMonth or
+	// precisionDay.
+	DatePublishedPrecision string    `gorm:"default:day"`
+	Version                int64     `gorm:"default:1"`
+	CreatedAt              time.Time `gorm:"autoCreateTime:true"`
+	UpdatedAt              time.Time `gorm:"autoUpdateTime:true"`
+	DeletedAt              gorm.DeletedAt
 }
 
 type User struct {
@@ -87,153 +97,228 @@ type User struct {
 	UserFname   string
 	UserLname   string
 	UserMname   string
+	Version     int64     `gorm:"default:1"`
 	CreatedAt   time.Time `gorm:"autoCreateTime:true"`
 	UpdatedAt   time.Time `gorm:"autoUpdateTime:true"`
 }
 
 type Log struct {
-	LogID       string `gorm:"primarykey"`
-	DateTime    string
-	UserID      int32
-	Activity    string
-	Description string
-	CreatedAt   time.Time `gorm:"autoCreateTime:true"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime:true"`
+	LogID    string `gorm:"primarykey"`
+	DateTime *time.Time
+	// DateTimeText is the text DateTime was stored as before it was typed, kept
+	// for the entries whose hash covers a different rendering; see logHash.
+	DateTimeText string
+	UserID       int32
+	Activity     string
+	Description  string
+	Method       string
+	Entity       string
+	EntityID     string
+	Changes      string
+	Seq          int64
+	PrevHash     string
+	Hash         string
+	CreatedAt    time.Time `gorm:"autoCreateTime:true"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime:true"`
 }
 
-func DatabaseConnection() {
-	host := "127.0.0.1"
-	port := "5432"
-	dbName := "rms_db"
-	dbUser := "postgres"
-	password := "password"
-	dsn := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable",
-		host,
-		port,
-		dbUser,
-		dbName,
-		password,
-	)
-	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
+func DatabaseConnection(cfg DBConfig) (*gorm.DB, error) {
+	var db *gorm.DB
+	var err error
+	backoff := time.Duration(cfg.ConnectBackoff)
+	for attempt := 1; ; attempt++ {
+		db, err = gorm.Open(postgres.Open(cfg.DSN()), &gorm.Config{})
+		if err == nil {
+			break
+		}
+		err = redactPassword(err, cfg.Password)
+		if attempt >= cfg.ConnectAttempts {
+			return nil, fmt.Errorf("connecting to %s failed after %d attempts: %w", cfg, attempt, err)
+		}
+		log.Printf("Error connecting to the database %s (attempt %d/%d), retrying in %s: %v",
+			cfg, attempt, cfg.ConnectAttempts, backoff, err)
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > maxConnectBackoff {
+			backoff = maxConnectBackoff
+		}
+	}
+
+	sqlDB, err := db.DB()
 	if err != nil {
-		log.Fatal("Error connecting to the database...", err)
+		return nil, err
 	}
-
-	DB.AutoMigrate(&Author{})
-	DB.AutoMigrate(&IP_Asset{})
-	DB.AutoMigrate(&Publication{})
-	DB.AutoMigrate(&User{})
-	DB.AutoMigrate(&Log{})
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime))
+	sqlDB.SetConnMaxIdleTime(time.Duration(cfg.ConnMaxIdleTime))
 
 	fmt.Println("Database connection successful...")
+	return db, nil
+}
+
+// redactPassword strips the password from driver errors before they are
+// logged or returned.
+func redactPassword(err error, password string) error {
+	if password == "" || !strings.Contains(err.Error(), password) {
+		return err
+	}
+	return errors.New(strings.ReplaceAll(err.Error(), password, "xxxxx"))
 }
 
 var (
-	port = flag.Int("port", 50051, "gRPC server port")
+	port        = flag.Int("port", 50051, "gRPC server port")
+	storeKind   = flag.String("store", "postgres", "storage backend: postgres or memory")
+	autoMigrate = flag.Bool("auto-migrate", true, "apply pending database migrations at startup")
 )
 
 type server struct {
 	pb.UnimplementedRMSServiceServer
+	authors      AuthorStore
+	ipAssets     IPAssetStore
+	publications PublicationStore
+	users        UserStore
+	logs         LogStore
+	search       SearchStore
+	links        AuthorLinkStore
+	trash        TrashStore
+	revisions    RevisionStore
+	vocabularies VocabularyStore
+	tx           TxStore
+	auth         *authenticator
+	// checkpointKey signs log checkpoints; nil disables exporting them.
+	checkpointKey ed25519.PrivateKey
+}
+
+func newServer(store Store, auth *authenticator, checkpointKey ed25519.PrivateKey) *server {
+	return &server{
+		authors:       store,
+		ipAssets:      store,
+		publications:  store,
+		users:         store,
+		logs:          store,
+		search:        store,
+		links:         store,
+		trash:         store,
+		revisions:     store,
+		vocabularies:  store,
+		tx:            store,
+		auth:          auth,
+		checkpointKey: checkpointKey,
+	}
+}
+
+// newStore opens the store of the given kind, first applying pending database
+// migrations when migrate is set.
+func newStore(kind string, cfg Config, migrate bool) (Store, error) {
+	switch kind {
+	case "postgres":
+		db, err := DatabaseConnection(cfg.Database)
+		if err != nil {
+			return nil, err
+		}
+		if migrate {
+			m, err := newMigrator(db)
+			if err != nil {
+				return nil, err
+			}
+			done, err := m.Up()
+			for _, mig := range done {
+				log.Printf("Applied migration %04d_%s", mig.Version, mig.Name)
+			}
+			if note := m.unparsedDatesNote(done); note != "" {
+				log.Print(note)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		return newGormStore(db), nil
+	case "memory":
+		return newMemoryStore(), nil
+	}
+	return nil, fmt.Errorf("unknown store %q", kind)
 }
 
 // Author
-func (*server) CreateAuthor(ctx context.Context, req *pb.CreateAuthorRequest) (*pb.CreateAuthorResponse, error) {
+func (s *server) CreateAuthor(ctx context.Context, req *pb.CreateAuthorRequest) (*pb.CreateAuthorResponse, error) {
 	fmt.Println("Create Author")
-	author := req.GetAuthor()
-	author.AuthorId = uuid.New().String()
+	author := authorFromProto(req.GetAuthor())
+	author.ID = uuid.New().String()
 
-	data := Author{
-		ID:           author.GetAuthorId(),
-		AuthorName:   author.GetAuthorName(),
-		AuthorGender: author.GetGender(),
-		TypeofAuthor: author.GetTypeOfAuthor(),
-		Affiliation:  author.GetAffiliation(),
-		AuthorEmail:  author.GetEmail(),
-	}
-
-	res := DB.Table("table_authors").Create(&data)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("author creation unsuccessful")
+	if err := s.authors.CreateAuthor(ctx, &author); err != nil {
+		return nil, storeError(err, "author", author.ID)
 	}
 	return &pb.CreateAuthorResponse{
-		Author: &pb.Author{
-			AuthorId:     author.GetAuthorId(),
-			AuthorName:   author.GetAuthorName(),
-			Gender:       author.GetGender(),
-			TypeOfAuthor: author.GetTypeOfAuthor(),
-			Affiliation:  author.GetAffiliation(),
-			Email:        author.GetEmail(),
-		},
+		Author: authorToProto(&author),
 	}, nil
 }
 
-func (*server) GetAuthor(ctx context.Context, req *pb.ReadAuthorRequest) (*pb.ReadAuthorResponse, error) {
+func (s *server) GetAuthor(ctx context.Context, req *pb.ReadAuthorRequest) (*pb.ReadAuthorResponse, error) {
 	fmt.Println("Read Author", req.GetAuthorId())
-	var author Author
-	res := DB.Table("table_authors").Find(&author, "author_id = ?", req.GetAuthorId())
-	if res.RowsAffected == 0 {
-		return nil, errors.New("Author not found")
+	author, err := s.authors.GetAuthor(ctx, req.GetAuthorId())
+	if err != nil {
+		return nil, storeError(err, "author", req.GetAuthorId())
 	}
 	return &pb.ReadAuthorResponse{
-		Author: &pb.Author{
-			AuthorId:     author.ID,
-			AuthorName:   author.AuthorName,
-			Gender:       author.AuthorGender,
-			TypeOfAuthor: author.TypeofAuthor,
-			Affiliation:  author.Affiliation,
-			Email:        author.AuthorEmail,
-		},
+		Author: authorToProto(author),
 	}, nil
 }
 
-func (*server) GetAuthors(ctx context.Context, req *pb.ReadAuthorsRequest) (*pb.ReadAuthorsResponse, error) {
+func (s *server) GetAuthors(ctx context.Context, req *pb.ReadAuthorsRequest) (*pb.ReadAuthorsResponse, error) {
 	fmt.Println("Read Authors")
+	return s.listAuthors(ctx, req, false)
+}
+
+// listAuthors lists the live authors, or the trashed ones when deleted is set.
+func (s *server) listAuthors(ctx context.Context, req *pb.ReadAuthorsRequest, deleted bool) (*pb.ReadAuthorsResponse, error) {
+	p, err := newPager(req, authorQuery)
+	if err != nil {
+		return nil, err
+	}
+	p.opts.Deleted = deleted
+	list, err := s.authors.ListAuthors(ctx, p.opts)
+	if err != nil {
+		return nil, storeError(err, "author", "")
+	}
+	list, next := nextPage(p, list)
 	authors := []*pb.Author{}
-	res := DB.Table("table_authors").Find(&authors)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("author not found")
+	for i := range list {
+		authors = append(authors, authorToProto(&list[i]))
 	}
-	return &pb.ReadAuthorsResponse{
-		Authors: authors,
-	}, nil
+	res := &pb.ReadAuthorsResponse{
+		Authors:       authors,
+		NextPageToken: next,
+	}
+	if req.GetIncludeTotalSize() {
+		if res.TotalSize, err = s.authors.CountAuthors(ctx, p.opts); err != nil {
+			return nil, storeError(err, "author", "")
+		}
+	}
+	return res, nil
 }
 
-func (*server) UpdateAuthor(ctx context.Context, req *pb.UpdateAuthorRequest) (*pb.UpdateAuthorResponse, error) {
+func (s *server) UpdateAuthor(ctx context.Context, req *pb.UpdateAuthorRequest) (*pb.UpdateAuthorResponse, error) {
 	fmt.Println("Update Author")
-	var author Author
-	reqAuthor := req.GetAuthor()
-
-	res := DB.Table("table_authors").Model(&author).Where("author_id = ?", reqAuthor.AuthorId).Updates(
-		Author{
-			AuthorName:   reqAuthor.AuthorName,
-			AuthorGender: reqAuthor.Gender,
-			TypeofAuthor: reqAuthor.TypeOfAuthor,
-			Affiliation:  reqAuthor.Affiliation,
-			AuthorEmail:  reqAuthor.Email,
-		})
+	reqAuthor := authorFromProto(req.GetAuthor())
+	fields, err := maskFields(req.GetUpdateMask(), authorMaskFields)
+	if err != nil {
+		return nil, err
+	}
 
-	if res.RowsAffected == 0 {
-		return nil, errors.New("Author not found")
+	author, err := s.authors.UpdateAuthor(ctx, &reqAuthor, fields...)
+	if err != nil {
+		return nil, storeError(err, "author", reqAuthor.ID)
 	}
 
 	return &pb.UpdateAuthorResponse{
-		Author: &pb.Author{
-			AuthorId:     author.ID,
-			AuthorName:   author.AuthorName,
-			Gender:       author.AuthorGender,
-			TypeOfAuthor: author.TypeofAuthor,
-			Affiliation:  author.Affiliation,
-			Email:        author.AuthorEmail,
-		},
+		Author: authorToProto(author),
 	}, nil
 }
 
-func (*server) DeleteAuthor(ctx context.Context, req *pb.DeleteAuthorRequest) (*pb.DeleteAuthorResponse, error) {
+func (s *server) DeleteAuthor(ctx context.Context, req *pb.DeleteAuthorRequest) (*pb.DeleteAuthorResponse, error) {
 	fmt.Println("Delete Author")
-	var author Author
-	res := DB.Table("table_authors").Where("author_id = ?", req.GetAuthorId()).Delete(&author)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("author not found")
+	if err := s.authors.DeleteAuthor(ctx, req.GetAuthorId(), req.GetVersion()); err != nil {
+		return nil, storeError(err, "author", req.GetAuthorId())
 	}
 
 	return &pb.DeleteAuthorResponse{
@@ -242,138 +327,98 @@ func (*server) DeleteAuthor(ctx context.Context, req *pb.DeleteAuthorRequest) (*
 }
 
 // IP_Asset
-func (*server) CreateIP_Asset(ctx context.Context, req *pb.CreateIP_AssetRequest) (*pb.CreateIP_AssetResponse, error) {
+func (s *server) CreateIP_Asset(ctx context.Context, req *pb.CreateIP_AssetRequest) (*pb.CreateIP_AssetResponse, error) {
 	fmt.Println("Create IP_Asset")
-	ipAsset := req.GetIpAsset()
-	ipAsset.RegistrationNumber = uuid.New().String()
-
-	data := IP_Asset{
-		RegistrationNumber: ipAsset.GetRegistrationNumber(),
-		TitleOfWork:        ipAsset.GetTitleOfWork(),
-		TypeOfDocument:     ipAsset.GetTypeOfDocument(),
-		ClassOfWork:        ipAsset.GetClassOfWork(),
-		DateOfCreation:     ipAsset.GetDateOfCreation(),
-		DateRegistered:     ipAsset.GetDateRegistered(),
-		Campus:             ipAsset.GetCampus(),
-		College:            ipAsset.GetCollege(),
-		Program:            ipAsset.GetProgram(),
-		Authors:            ipAsset.GetAuthors(),
-		Hyperlink:          ipAsset.GetHyperlink(),
-		Status:             ipAsset.GetStatus(),
-		Certificate:        ipAsset.GetCertificate(),
-	}
-
-	res := DB.Table("table_ipassets").Create(&data)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("IP_asset creation unsuccessful")
+	ipAsset := ipAssetFromProto(req.GetIpAsset())
+	if ipAsset.RegistrationNumber == "" || !keepsKey(ctx) {
+		ipAsset.RegistrationNumber = uuid.New().String()
+	}
+
+	if err := s.ipAssets.CreateIPAsset(ctx, &ipAsset); err != nil {
+		return nil, storeError(err, "IP_asset", ipAsset.RegistrationNumber)
 	}
 	return &pb.CreateIP_AssetResponse{
-		IpAsset: &pb.IP_Asset{
-			RegistrationNumber: ipAsset.GetRegistrationNumber(),
-			TitleOfWork:        ipAsset.GetTitleOfWork(),
-			TypeOfDocument:     ipAsset.GetTypeOfDocument(),
-			ClassOfWork:        ipAsset.GetClassOfWork(),
-			DateOfCreation:     ipAsset.GetDateOfCreation(),
-			DateRegistered:     ipAsset.GetDateRegistered(),
-			Campus:             ipAsset.GetCampus(),
-			College:            ipAsset.GetCollege(),
-			Program:            ipAsset.GetProgram(),
-			Authors:            ipAsset.GetAuthors(),
-			Hyperlink:          ipAsset.GetHyperlink(),
-			Status:             ipAsset.GetStatus(),
-			Certificate:        ipAsset.GetCertificate(),
-		},
+		IpAsset: ipAssetToProto(&ipAsset),
 	}, nil
 }
 
-func (*server) GetIP_Asset(ctx context.Context, req *pb.ReadIP_AssetRequest) (*pb.ReadIP_AssetResponse, error) {
+func (s *server) GetIP_Asset(ctx context.Context, req *pb.ReadIP_AssetRequest) (*pb.ReadIP_AssetResponse, error) {
 	fmt.Println("Read IP_assets", req.GetRegistrationNumber())
-	var ipAsset IP_Asset
-	res := DB.Table("table_ipassets").Find(&ipAsset, "registration_number = ?", req.GetRegistrationNumber())
-	if res.RowsAffected == 0 {
-		return nil, errors.New("IP_asset not found")
+	ipAsset, err := s.ipAssets.GetIPAsset(ctx, req.GetRegistrationNumber())
+	if err != nil {
+		return nil, storeError(err, "IP_asset", req.GetRegistrationNumber())
+	}
+	res := ipAssetToProto(ipAsset)
+	if err := s.withIPAssetAuthors(ctx, res); err != nil {
+		return nil, err
 	}
 	return &pb.ReadIP_AssetResponse{
-		IpAsset: &pb.IP_Asset{
-			RegistrationNumber: ipAsset.RegistrationNumber,
-			TitleOfWork:        ipAsset.TitleOfWork,
-			TypeOfDocument:     ipAsset.TypeOfDocument,
-			ClassOfWork:        ipAsset.ClassOfWork,
-			DateOfCreation:     ipAsset.DateOfCreation,
-			DateRegistered:     ipAsset.DateRegistered,
-			Campus:             ipAsset.Campus,
-			College:            ipAsset.College,
-			Program:            ipAsset.Program,
-			Authors:            ipAsset.Authors,
-			Hyperlink:          ipAsset.Hyperlink,
-			Status:             ipAsset.Status,
-			Certificate:        ipAsset.Certificate,
-		},
+		IpAsset: res,
 	}, nil
 }
 
-func (*server) GetIP_Assets(ctx context.Context, req *pb.ReadIP_AssetsRequest) (*pb.ReadIP_AssetsResponse, error) {
+func (s *server) GetIP_Assets(ctx context.Context, req *pb.ReadIP_AssetsRequest) (*pb.ReadIP_AssetsResponse, error) {
 	fmt.Println("Read IP_assets")
+	return s.listIPAssets(ctx, req, false)
+}
+
+// listIPAssets lists the live IP assets, or the trashed ones when deleted is set.
+func (s *server) listIPAssets(ctx context.Context, req *pb.ReadIP_AssetsRequest, deleted bool) (*pb.ReadIP_AssetsResponse, error) {
+	p, err := newPager(req, ipAssetQuery)
+	if err != nil {
+		return nil, err
+	}
+	p.opts.Deleted = deleted
+	list, err := s.ipAssets.ListIPAssets(ctx, p.opts)
+	if err != nil {
+		return nil, storeError(err, "IP_asset", "")
+	}
+	list, next := nextPage(p, list)
 	ipAssets := []*pb.IP_Asset{}
-	res := DB.Table("table_ipassets").Find(&ipAssets)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("IP_asset not found")
+	for i := range list {
+		ipAssets = append(ipAssets, ipAssetToProto(&list[i]))
 	}
-	return &pb.ReadIP_AssetsResponse{
-		IpAssets: ipAssets,
-	}, nil
+	if err := s.withIPAssetAuthors(ctx, ipAssets...); err != nil {
+		return nil, err
+	}
+	res := &pb.ReadIP_AssetsResponse{
+		IpAssets:      ipAssets,
+		NextPageToken: next,
+	}
+	if req.GetIncludeTotalSize() {
+		if res.TotalSize, err = s.ipAssets.CountIPAssets(ctx, p.opts); err != nil {
+			return nil, storeError(err, "IP_asset", "")
+		}
+	}
+	return res, nil
 }
 
-func (*server) UpdateIP_Asset(ctx context.Context, req *pb.UpdateIP_AssetRequest) (*pb.UpdateIP_AssetResponse, error) {
+func (s *server) UpdateIP_Asset(ctx context.Context, req *pb.UpdateIP_AssetRequest) (*pb.UpdateIP_AssetResponse, error) {
 	fmt.Println("Update IP_assets")
-	var ipAsset IP_Asset
-	reqIPAsset := req.GetIpAsset()
-
-	res := DB.Table("table_ipassets").Model(&ipAsset).Where("registration_number = ?", reqIPAsset.RegistrationNumber).Updates(
-		IP_Asset{
-			TitleOfWork:    reqIPAsset.TitleOfWork,
-			TypeOfDocument: reqIPAsset.TypeOfDocument,
-			ClassOfWork:    reqIPAsset.ClassOfWork,
-			DateOfCreation: reqIPAsset.DateOfCreation,
-			DateRegistered: reqIPAsset.DateRegistered,
-			Campus:         reqIPAsset.Campus,
-			College:        reqIPAsset.College,
-			Program:        reqIPAsset.Program,
-			Authors:        reqIPAsset.Authors,
-			Hyperlink:      reqIPAsset.Hyperlink,
-			Status:         reqIPAsset.Status,
-			Certificate:    reqIPAsset.Certificate,
-		})
-
-	if res.RowsAffected == 0 {
-		return nil, errors.New("IP_asset not found")
+	reqIPAsset := ipAssetFromProto(req.GetIpAsset())
+	fields, err := maskFields(req.GetUpdateMask(), ipAssetMaskFields)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAsset, err := s.ipAssets.UpdateIPAsset(ctx, &reqIPAsset, fields...)
+	if err != nil {
+		return nil, storeError(err, "IP_asset", reqIPAsset.RegistrationNumber)
+	}
+	res := ipAssetToProto(ipAsset)
+	if err := s.withIPAssetAuthors(ctx, res); err != nil {
+		return nil, err
 	}
 
 	return &pb.UpdateIP_AssetResponse{
-		IpAsset: &pb.IP_Asset{
-			RegistrationNumber: ipAsset.RegistrationNumber,
-			TitleOfWork:        ipAsset.TitleOfWork,
-			TypeOfDocument:     ipAsset.TypeOfDocument,
-			ClassOfWork:        ipAsset.ClassOfWork,
-			DateOfCreation:     ipAsset.DateOfCreation,
-			DateRegistered:     ipAsset.DateRegistered,
-			Campus:             ipAsset.Campus,
-			College:            ipAsset.College,
-			Program:            ipAsset.Program,
-			Authors:            ipAsset.Authors,
-			Hyperlink:          ipAsset.Hyperlink,
-			Status:             ipAsset.Status,
-			Certificate:        ipAsset.Certificate,
-		},
+		IpAsset: res,
 	}, nil
 }
 
-func (*server) DeleteIP_Asset(ctx context.Context, req *pb.DeleteIP_AssetRequest) (*pb.DeleteIP_AssetResponse, error) {
+func (s *server) DeleteIP_Asset(ctx context.Context, req *pb.DeleteIP_AssetRequest) (*pb.DeleteIP_AssetResponse, error) {
 	fmt.Println("Delete IP_assets")
-	var ipAsset IP_Asset
-	res := DB.Table("table_ipassets").Where("registration_number = ?", req.GetRegistrationNumber()).Delete(&ipAsset)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("IP_asset not found")
+	if err := s.ipAssets.DeleteIPAsset(ctx, req.GetRegistrationNumber(), req.GetVersion()); err != nil {
+		return nil, storeError(err, "IP_asset", req.GetRegistrationNumber())
 	}
 
 	return &pb.DeleteIP_AssetResponse{
@@ -382,161 +427,101 @@ func (*server) DeleteIP_Asset(ctx context.Context, req *pb.DeleteIP_AssetRequest
 }
 
 // Publication
-func (*server) CreatePublication(ctx context.Context, req *pb.CreatePublicationRequest) (*pb.CreatePublicationResponse, error) {
+func (s *server) CreatePublication(ctx context.Context, req *pb.CreatePublicationRequest) (*pb.CreatePublicationResponse, error) {
 	fmt.Println("Create Publication")
-	publication := req.GetPublication()
-	publication.PublicationId = uuid.New().String()
-
-	data := Publication{
-		PublicationID:        publication.GetPublicationId(),
-		DatePublished:        publication.GetDatePublished(),
-		Quartile:             publication.GetQuartile(),
-		Authors:              publication.GetAuthors(),
-		Department:           publication.GetDepartment(),
-		College:              publication.GetCollege(),
-		Campus:               publication.GetCampus(),
-		TitleOfPaper:         publication.GetTitleOfPaper(),
-		TypeOfPublication:    publication.GetTypeOfPublication(),
-		FundingSource:        publication.GetFundingSource(),
-		NumberOfCitation:     publication.GetNumberOfCitation(),
-		GoogleScholarDetails: publication.GetGoogleScholarDetails(),
-		SDGNo:                publication.GetSdgNo(),
-		FundingType:          publication.GetFundingType(),
-		NatureOfFunding:      publication.GetNatureOfFunding(),
-		Publisher:            publication.GetPublisher(),
-		Abstract:             publication.GetAbstract(),
-	}
-
-	res := DB.Table("table_publications").Create(&data)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("publication creation unsuccessful")
+	publication := publicationFromProto(req.GetPublication())
+	if publication.PublicationID == "" || !keepsKey(ctx) {
+		publication.PublicationID = uuid.New().String()
+	}
+
+	if err := s.publications.CreatePublication(ctx, &publication); err != nil {
+		return nil, storeError(err, "publication", publication.PublicationID)
 	}
 
 	return &pb.CreatePublicationResponse{
-		Publication: &pb.Publication{
-			PublicationId:        publication.GetPublicationId(),
-			DatePublished:        publication.GetDatePublished(),
-			Quartile:             publication.GetQuartile(),
-			Authors:              publication.GetAuthors(),
-			Department:           publication.GetDepartment(),
-			College:              publication.GetCollege(),
-			Campus:               publication.GetCampus(),
-			TitleOfPaper:         publication.GetTitleOfPaper(),
-			TypeOfPublication:    publication.GetTypeOfPublication(),
-			FundingSource:        publication.GetFundingSource(),
-			NumberOfCitation:     publication.GetNumberOfCitation(),
-			GoogleScholarDetails: publication.GetGoogleScholarDetails(),
-			SdgNo:                publication.GetSdgNo(),
-			FundingType:          publication.GetFundingType(),
-			NatureOfFunding:      publication.GetNatureOfFunding(),
-			Publisher:            publication.GetPublisher(),
-			Abstract:             publication.GetAbstract(),
-		},
+		Publication: publicationToProto(&publication),
 	}, nil
 }
 
-func (*server) GetPublication(ctx context.Context, req *pb.ReadPublicationRequest) (*pb.ReadPublicationResponse, error) {
+func (s *server) GetPublication(ctx context.Context, req *pb.ReadPublicationRequest) (*pb.ReadPublicationResponse, error) {
 	fmt.Println("Read Publication", req.GetPublicationId())
-	var publication Publication
-	res := DB.Table("table_publications").Find(&publication, "publication_id = ?", req.GetPublicationId())
-	if res.RowsAffected == 0 {
-		return nil, errors.New("publication not found")
+	publication, err := s.publications.GetPublication(ctx, req.GetPublicationId())
+	if err != nil {
+		return nil, storeError(err, "publication", req.GetPublicationId())
+	}
+	res := publicationToProto(publication)
+	if err := s.withPublicationAuthors(ctx, res); err != nil {
+		return nil, err
 	}
 
 	return &pb.ReadPublicationResponse{
-		Publication: &pb.Publication{
-			PublicationId:        publication.PublicationID,
-			DatePublished:        publication.DatePublished,
-			Quartile:             publication.Quartile,
-			Authors:              publication.Authors,
-			Department:           publication.Department,
-			College:              publication.College,
-			Campus:               publication.Campus,
-			TitleOfPaper:         publication.TitleOfPaper,
-			TypeOfPublication:    publication.TypeOfPublication,
-			FundingSource:        publication.FundingSource,
-			NumberOfCitation:     publication.NumberOfCitation,
-			GoogleScholarDetails: publication.GoogleScholarDetails,
-			SdgNo:                publication.SDGNo,
-			FundingType:          publication.FundingType,
-			NatureOfFunding:      publication.NatureOfFunding,
-			Publisher:            publication.Publisher,
-			Abstract:             publication.Abstract,
-		},
+		Publication: res,
 	}, nil
 }
 
-func (*server) GetPublications(ctx context.Context, req *pb.ReadPublicationsRequest) (*pb.ReadPublicationsResponse, error) {
+func (s *server) GetPublications(ctx context.Context, req *pb.ReadPublicationsRequest) (*pb.ReadPublicationsResponse, error) {
 	fmt.Println("Read Publications")
+	return s.listPublications(ctx, req, false)
+}
+
+// listPublications lists the live publications, or the trashed ones when deleted is set.
+func (s *server) listPublications(ctx context.Context, req *pb.ReadPublicationsRequest, deleted bool) (*pb.ReadPublicationsResponse, error) {
+	p, err := newPager(req, publicationQuery)
+	if err != nil {
+		return nil, err
+	}
+	p.opts.Deleted = deleted
+	list, err := s.publications.ListPublications(ctx, p.opts)
+	if err != nil {
+		return nil, storeError(err, "publication", "")
+	}
+	list, next := nextPage(p, list)
 	publications := []*pb.Publication{}
-	res := DB.Table("table_publications").Find(&publications)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("publications not found")
+	for i := range list {
+		publications = append(publications, publicationToProto(&list[i]))
+	}
+	if err := s.withPublicationAuthors(ctx, publications...); err != nil {
+		return nil, err
 	}
 
-	return &pb.ReadPublicationsResponse{
-		Publications: publications,
-	}, nil
+	res := &pb.ReadPublicationsResponse{
+		Publications:  publications,
+		NextPageToken: next,
+	}
+	if req.GetIncludeTotalSize() {
+		if res.TotalSize, err = s.publications.CountPublications(ctx, p.opts); err != nil {
+			return nil, storeError(err, "publication", "")
+		}
+	}
+	return res, nil
 }
 
-func (*server) UpdatePublication(ctx context.Context, req *pb.UpdatePublicationRequest) (*pb.UpdatePublicationResponse, error) {
+func (s *server) UpdatePublication(ctx context.Context, req *pb.UpdatePublicationRequest) (*pb.UpdatePublicationResponse, error) {
 	fmt.Println("Update Publication")
-	var publication Publication
-	reqPublication := req.GetPublication()
-
-	res := DB.Table("table_publications").Model(&publication).Where("publication_id = ?", reqPublication.PublicationId).Updates(
-		Publication{
-			DatePublished:        reqPublication.DatePublished,
-			Quartile:             reqPublication.Quartile,
-			Authors:              reqPublication.Authors,
-			Department:           reqPublication.Department,
-			College:              reqPublication.College,
-			Campus:               reqPublication.Campus,
-			TitleOfPaper:         reqPublication.TitleOfPaper,
-			TypeOfPublication:    reqPublication.TypeOfPublication,
-			FundingSource:        reqPublication.FundingSource,
-			NumberOfCitation:     reqPublication.NumberOfCitation,
-			GoogleScholarDetails: reqPublication.GoogleScholarDetails,
-			SDGNo:                reqPublication.SdgNo,
-			FundingType:          reqPublication.FundingType,
-			NatureOfFunding:      reqPublication.NatureOfFunding,
-			Publisher:            reqPublication.Publisher,
-			Abstract:             reqPublication.Abstract,
-		})
-
-	if res.RowsAffected == 0 {
-		return nil, errors.New("publication not found")
+	reqPublication := publicationFromProto(req.GetPublication())
+	fields, err := maskFields(req.GetUpdateMask(), publicationMaskFields)
+	if err != nil {
+		return nil, err
+	}
+
+	publication, err := s.publications.UpdatePublication(ctx, &reqPublication, fields...)
+	if err != nil {
+		return nil, storeError(err, "publication", reqPublication.PublicationID)
+	}
+	res := publicationToProto(publication)
+	if err := s.withPublicationAuthors(ctx, res); err != nil {
+		return nil, err
 	}
 
 	return &pb.UpdatePublicationResponse{
-		Publication: &pb.Publication{
-			PublicationId:        publication.PublicationID,
-			DatePublished:        publication.DatePublished,
-			Quartile:             publication.Quartile,
-			Authors:              publication.Authors,
-			Department:           publication.Department,
-			College:              publication.College,
-			Campus:               publication.Campus,
-			TitleOfPaper:         publication.TitleOfPaper,
-			TypeOfPublication:    publication.TypeOfPublication,
-			FundingSource:        publication.FundingSource,
-			NumberOfCitation:     publication.NumberOfCitation,
-			GoogleScholarDetails: publication.GoogleScholarDetails,
-			SdgNo:                publication.SDGNo,
-			FundingType:          publication.FundingType,
-			NatureOfFunding:      publication.NatureOfFunding,
-			Publisher:            publication.Publisher,
-			Abstract:             publication.Abstract,
-		},
+		Publication: res,
 	}, nil
 }
 
-func (*server) DeletePublication(ctx context.Context, req *pb.DeletePublicationRequest) (*pb.DeletePublicationResponse, error) {
+func (s *server) DeletePublication(ctx context.Context, req *pb.DeletePublicationRequest) (*pb.DeletePublicationResponse, error) {
 	fmt.Println("Delete Publication")
-	var publication Publication
-	res := DB.Table("table_publications").Where("publication_id = ?", req.GetPublicationId()).Delete(&publication)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("publication not found")
+	if err := s.publications.DeletePublication(ctx, req.GetPublicationId(), req.GetVersion()); err != nil {
+		return nil, storeError(err, "publication", req.GetPublicationId())
 	}
 
 	return &pb.DeletePublicationResponse{
@@ -545,124 +530,101 @@ func (*server) DeletePublication(ctx context.Context, req *pb.DeletePublicationR
 }
 
 // User
-func (*server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
+func (s *server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
 	fmt.Println("Create User")
-	user := req.GetUser()
-
-	data := User{
-		UserID:      user.GetUserId(),
-		SRCode:      user.GetSrCode(),
-		Email:       user.GetEmail(),
-		Password:    user.GetPassword(),
-		AccountType: user.GetAccountType(),
-		UserContact: user.GetUserContact(),
-		UserImg:     user.GetUserImg(),
-		UserFname:   user.GetUserFname(),
-		UserLname:   user.GetUserLname(),
-		UserMname:   user.GetUserMname(),
+	user := userFromProto(req.GetUser())
+	if user.Password != "" {
+		hash, err := hashPassword(user.Password)
+		if err != nil {
+			return nil, storeError(err, "user", "")
+		}
+		user.Password = hash
 	}
 
-	res := DB.Table("table_user").Create(&data)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("user creation unsuccessful")
+	if err := s.users.CreateUser(ctx, &user); err != nil {
+		return nil, storeError(err, "user", fmt.Sprint(user.UserID))
 	}
 
 	return &pb.CreateUserResponse{
-		User: &pb.User{
-			UserId:      user.GetUserId(),
-			SrCode:      user.GetSrCode(),
-			Email:       user.GetEmail(),
-			Password:    user.GetPassword(),
-			AccountType: user.GetAccountType(),
-			UserContact: user.GetUserContact(),
-			UserImg:     user.GetUserImg(),
-			UserFname:   user.GetUserFname(),
-			UserLname:   user.GetUserLname(),
-			UserMname:   user.GetUserMname(),
-		},
+		User: userToProto(&user),
 	}, nil
 }
 
-func (*server) GetUser(ctx context.Context, req *pb.ReadUserRequest) (*pb.ReadUserResponse, error) {
+func (s *server) GetUser(ctx context.Context, req *pb.ReadUserRequest) (*pb.ReadUserResponse, error) {
 	fmt.Println("Read User", req.GetUserId())
-	var user User
-	res := DB.Table("table_user").Find(&user, "user_id = ?", req.GetUserId())
-	if res.RowsAffected == 0 {
-		return nil, errors.New("User not found")
+	if id, ok := selfScope(ctx); ok && id != req.GetUserId() {
+		return nil, errNotSelf()
+	}
+	user, err := s.users.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, storeError(err, "user", fmt.Sprint(req.GetUserId()))
 	}
 
 	return &pb.ReadUserResponse{
-		User: &pb.User{
-			UserId:      user.UserID,
-			SrCode:      user.SRCode,
-			Email:       user.Email,
-			Password:    user.Password,
-			AccountType: user.AccountType,
-			UserContact: user.UserContact,
-			UserImg:     user.UserImg,
-			UserFname:   user.UserFname,
-			UserLname:   user.UserLname,
-			UserMname:   user.UserMname,
-		},
+		User: userToProto(user),
 	}, nil
 }
 
-func (*server) GetUsers(ctx context.Context, req *pb.ReadUsersRequest) (*pb.ReadUsersResponse, error) {
+func (s *server) GetUsers(ctx context.Context, req *pb.ReadUsersRequest) (*pb.ReadUsersResponse, error) {
 	fmt.Println("Read Users")
+	p, err := newPager(req, userQuery)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.users.ListUsers(ctx, p.opts)
+	if err != nil {
+		return nil, storeError(err, "user", "")
+	}
+	list, next := nextPage(p, list)
 	users := []*pb.User{}
-	res := DB.Table("table_user").Find(&users)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("Users not found")
+	for i := range list {
+		users = append(users, userToProto(&list[i]))
 	}
 
-	return &pb.ReadUsersResponse{
-		Users: users,
-	}, nil
+	res := &pb.ReadUsersResponse{
+		Users:         users,
+		NextPageToken: next,
+	}
+	if req.GetIncludeTotalSize() {
+		if res.TotalSize, err = s.users.CountUsers(ctx, p.opts); err != nil {
+			return nil, storeError(err, "user", "")
+		}
+	}
+	return res, nil
 }
 
-func (*server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+func (s *server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
 	fmt.Println("Update User")
-	var user User
-	reqUser := req.GetUser()
-
-	res := DB.Table("table_user").Model(&user).Where("user_id = ?", reqUser.GetUserId()).Updates(
-		User{
-			SRCode:      reqUser.SrCode,
-			Email:       reqUser.Email,
-			Password:    reqUser.Password,
-			AccountType: reqUser.AccountType,
-			UserContact: reqUser.UserContact,
-			UserImg:     reqUser.UserImg,
-			UserFname:   reqUser.UserFname,
-			UserLname:   reqUser.UserLname,
-			UserMname:   reqUser.UserMname,
-		})
-	if res.RowsAffected == 0 {
-		return nil, errors.New("User not found")
+	reqUser := userFromProto(req.GetUser())
+	fields, err := maskFields(req.GetUpdateMask(), userMaskFields)
+	if err != nil {
+		return nil, err
+	}
+	if reqUser.Password == "" && hasField(fields, "Password") {
+		return nil, invalidArgument("update_mask", "password cannot be cleared")
+	}
+	if reqUser.Password != "" {
+		hash, err := hashPassword(reqUser.Password)
+		if err != nil {
+			return nil, storeError(err, "user", "")
+		}
+		reqUser.Password = hash
+	}
+
+	user, err := s.users.UpdateUser(ctx, &reqUser, fields...)
+	if err != nil {
+		return nil, storeError(err, "user", fmt.Sprint(reqUser.UserID))
 	}
 
 	return &pb.UpdateUserResponse{
-		User: &pb.User{
-			UserId:      user.UserID,
-			SrCode:      user.SRCode,
-			Email:       user.Email,
-			Password:    user.Password,
-			AccountType: user.AccountType,
-			UserContact: user.UserContact,
-			UserImg:     user.UserImg,
-			UserFname:   user.UserFname,
-			UserLname:   user.UserLname,
-			UserMname:   user.UserMname,
-		},
+		User: userToProto(user),
 	}, nil
 }
 
-func (*server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+func (s *server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
 	fmt.Println("Delete User")
-	var user User
-	res := DB.Table("table_user").Where("user_id = ?", req.GetUserId()).Delete(&user)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("User not found")
+	if err := s.users.DeleteUser(ctx, req.GetUserId(), req.GetVersion()); err != nil {
+		return nil, storeError(err, "user", fmt.Sprint(req.GetUserId()))
 	}
 
 	return &pb.DeleteUserResponse{
@@ -671,107 +633,117 @@ func (*server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.D
 }
 
 // Log
-func (*server) CreateLog(ctx context.Context, req *pb.CreateLogRequest) (*pb.CreateLogResponse, error) {
+func (s *server) CreateLog(ctx context.Context, req *pb.CreateLogRequest) (*pb.CreateLogResponse, error) {
 	fmt.Println("Create Log")
-	log := req.GetLog()
-
-	data := Log{
-		LogID:       log.GetLogId(),
-		DateTime:    log.GetDateTime(),
-		UserID:      log.GetUserId(),
-		Activity:    log.GetActivity(),
-		Description: log.GetDescription(),
+	log := logFromProto(req.GetLog())
+	log.LogID = uuid.New().String()
+	log.DateTime = logTime()
+	log.Method = "CreateLog"
+	log.Changes = "{}"
+	if p, ok := principalFrom(ctx); ok {
+		log.UserID = p.UserID
 	}
 
-	res := DB.Table("table_log").Create(&data)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("log creation unsuccessful")
+	if err := s.logs.CreateLog(ctx, &log); err != nil {
+		return nil, storeError(err, "log", log.LogID)
 	}
 
 	return &pb.CreateLogResponse{
-		Log: &pb.Log{
-			LogId:       log.GetLogId(),
-			DateTime:    log.GetDateTime(),
-			UserId:      log.GetUserId(),
-			Activity:    log.GetActivity(),
-			Description: log.GetDescription(),
-		},
+		Log: logToProto(&log),
 	}, nil
 }
 
-func (*server) GetLog(ctx context.Context, req *pb.ReadLogRequest) (*pb.ReadLogResponse, error) {
+func (s *server) GetLog(ctx context.Context, req *pb.ReadLogRequest) (*pb.ReadLogResponse, error) {
 	fmt.Println("Read Log", req.GetLogId())
-	var log Log
-	res := DB.Table("table_log").Find(&log, "log_id = ?", req.GetLogId())
-	if res.RowsAffected == 0 {
-		return nil, errors.New("Log not found")
+	log, err := s.logs.GetLog(ctx, req.GetLogId())
+	if err != nil {
+		return nil, storeError(err, "log", req.GetLogId())
+	}
+	if id, ok := selfScope(ctx); ok && id != log.UserID {
+		return nil, errNotSelf()
 	}
 
 	return &pb.ReadLogResponse{
-		Log: &pb.Log{
-			LogId:       log.LogID,
-			DateTime:    log.DateTime,
-			UserId:      log.UserID,
-			Activity:    log.Activity,
-			Description: log.Description,
-		},
+		Log: logToProto(log),
 	}, nil
 }
 
-func (*server) GetLogs(ctx context.Context, req *pb.ReadLogsRequest) (*pb.ReadLogsResponse, error) {
+func (s *server) GetLogs(ctx context.Context, req *pb.ReadLogsRequest) (*pb.ReadLogsResponse, error) {
 	fmt.Println("Read Logs")
+	p, err := newPager(req, logQuery)
+	if err != nil {
+		return nil, err
+	}
+	if id, ok := selfScope(ctx); ok {
+		p.opts.Filter = restrictFilter(p.opts.Filter, logQuery, "user_id", int64(id))
+	}
+	list, err := s.logs.ListLogs(ctx, p.opts)
+	if err != nil {
+		return nil, storeError(err, "log", "")
+	}
+	list, next := nextPage(p, list)
 	logs := []*pb.Log{}
-	res := DB.Table("table_log").Find(&logs)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("Logs not found")
+	for i := range list {
+		logs = append(logs, logToProto(&list[i]))
 	}
 
-	return &pb.ReadLogsResponse{
-		Logs: logs,
-	}, nil
+	res := &pb.ReadLogsResponse{
+		Logs:          logs,
+		NextPageToken: next,
+	}
+	if req.GetIncludeTotalSize() {
+		if res.TotalSize, err = s.logs.CountLogs(ctx, p.opts); err != nil {
+			return nil, storeError(err, "log", "")
+		}
+	}
+	return res, nil
 }
 
-func (*server) UpdateLog(ctx context.Context, req *pb.UpdateLogRequest) (*pb.UpdateLogResponse, error) {
+func (s *server) UpdateLog(ctx context.Context, req *pb.UpdateLogRequest) (*pb.UpdateLogResponse, error) {
 	fmt.Println("Update Log")
-	var log Log
-	reqLog := req.GetLog()
-
-	res := DB.Table("table_log").Model(&log).Where("log_id = ?", reqLog.GetLogId()).Updates(
-		Log{
-			DateTime:    reqLog.DateTime,
-			UserID:      reqLog.UserId,
-			Activity:    reqLog.Activity,
-			Description: reqLog.Description,
-		})
-	if res.RowsAffected == 0 {
-		return nil, errors.New("Log not found")
-	}
-
-	return &pb.UpdateLogResponse{
-		Log: &pb.Log{
-			LogId:       log.LogID,
-			DateTime:    log.DateTime,
-			UserId:      log.UserID,
-			Activity:    log.Activity,
-			Description: log.Description,
-		},
-	}, nil
+	return nil, errLogAppendOnly
 }
 
-func (*server) DeleteLog(ctx context.Context, req *pb.DeleteLogRequest) (*pb.DeleteLogResponse, error) {
+func (s *server) DeleteLog(ctx context.Context, req *pb.DeleteLogRequest) (*pb.DeleteLogResponse, error) {
 	fmt.Println("Delete Log")
-	var log Log
-	res := DB.Table("table_log").Where("log_id = ?", req.GetLogId()).Delete(&log)
-	if res.RowsAffected == 0 {
-		return nil, errors.New("Log not found")
-	}
-
-	return &pb.DeleteLogResponse{
-		Success: true,
-	}, nil
+	return nil, errLogAppendOnly
 }
 
 func main() {
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(cfg, flag.Args()[1:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if flag.Arg(0) == "logchain" {
+		if err := runLogChain(cfg, flag.Args()[1:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	key, err := checkpointKey(cfg.Audit)
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	store, err := newStore(*storeKind, cfg, *autoMigrate)
+	if err != nil {
+		log.Fatalf("Failed to open %s store: %v", *storeKind, err)
+	}
+	auth, err := newAuthenticator(cfg.Auth)
+	if err != nil {
+		log.Fatalf("Failed to set up authentication: %v", err)
+	}
+	if err := ensureAdmin(context.Background(), store, cfg.Auth); err != nil {
+		log.Fatalf("Failed to create admin user: %v", err)
+	}
+
 	fmt.Println("gRPC server running ...")
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
@@ -780,9 +752,18 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()
+	srv := newServer(store, auth, key)
+	if cfg.Trash.Retention > 0 {
+		go srv.purgeTrash(context.Background(), cfg.Trash)
+	}
+	interceptors := append([]grpc.UnaryServerInterceptor{auth.unaryInterceptor}, srv.interceptors()...)
+	s := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxMessageSize),
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.StreamInterceptor(streamInterceptor(interceptors)),
+	)
 
-	pb.RegisterRMSServiceServer(s, &server{})
+	pb.RegisterRMSServiceServer(s, srv)
 
 	log.Printf("Server listening at %v", lis.Addr())
 
@@ -793,5 +774,19 @@ func main() {
 
 // deploy server command
 // go run server/main.go
+// deploy server without a database
+// go run ./server -store=memory
+// create a user to log in with on a fresh database
+// go run ./server -auth-admin-email=admin@example.com -auth-admin-password-file=admin-password.txt
+// apply, roll back or list database migrations
+// go run ./server migrate up|down|status
+// list the dates migration 0009 could not parse
+// go run ./server migrate dates
+// verify the audit log hash chain, export a signed checkpoint or create a checkpoint key
+// go run ./server logchain verify [checkpoint.json]|checkpoint|keygen
+// keep deleted records in the trash for 90 days instead of 30 (0 never purges)
+// go run ./server -trash-retention=2160h
 // run client command
-// go run client/main.go
+// go run ./client
+// import IP assets or publications from CSV or JSON Lines, checking them first
+// RMS_TOKEN=... go run ./client import -map mapping.json -dry-run publication publications.csv
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// testServer is a server backed by a memory store, with a user of each role
+// to call it as.
+type testServer struct {
+	*server
+	store *memoryStore
+	users map[string]*User
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	auth, err := newAuthenticator(AuthConfig{SigningKey: "0123456789abcdef0123456789abcdef", Issuer: "rms-test", TokenTTL: Duration(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := newMemoryStore()
+	ts := &testServer{server: newServer(store, auth, nil), store: store, users: map[string]*User{}}
+	for _, accountType := range []string{"Admin", "Research Office", "Faculty", "Student"} {
+		user := &User{Email: normalizeRole(accountType) + "@example.com", AccountType: accountType}
+		if err := store.CreateUser(context.Background(), user); err != nil {
+			t.Fatal(err)
+		}
+		ts.users[normalizeRole(accountType)] = user
+	}
+	return ts
+}
+
+// as returns the context of a call by the user of role.
+func (ts *testServer) as(role string) context.Context {
+	user := ts.users[role]
+	return withPrincipal(context.Background(), &principal{UserID: user.UserID, Role: user.AccountType})
+}
+
+// call calls a method of the server, through the interceptors of a call of its
+// own.
+func call[Req, Res any](ctx context.Context, ts *testServer, method string, handler func(*server, context.Context, Req) (Res, error), req Req) (Res, error) {
+	res, err := ts.call(ctx, method, req, func(ctx context.Context, req any) (any, error) {
+		return handler(ts.server, ctx, req.(Req))
+	})
+	if err != nil {
+		var zero Res
+		return zero, err
+	}
+	return res.(Res), nil
+}
+
+// wantCode fails the test unless err is a status with code.
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != code {
+		t.Fatalf("got %v (%v), want %v", got, err, code)
+	}
+}
+
+func TestAuthorCRUD(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+
+	created, err := ts.CreateAuthor(ctx, &pb.CreateAuthorRequest{
+		Author: &pb.Author{AuthorName: "Juan Dela Cruz", Email: "juan@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetAuthor().GetAuthorId()
+	if id == "" || created.GetAuthor().GetVersion() != 1 {
+		t.Fatalf("created %v, want an ID and version 1", created.GetAuthor())
+	}
+
+	read, err := ts.GetAuthor(ctx, &pb.ReadAuthorRequest{AuthorId: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.GetAuthor().GetAuthorName() != "Juan Dela Cruz" {
+		t.Fatalf("read %v", read.GetAuthor())
+	}
+
+	updated, err := ts.UpdateAuthor(ctx, &pb.UpdateAuthorRequest{
+		Author: &pb.Author{AuthorId: id, Affiliation: "College of Engineering", Version: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := updated.GetAuthor(); a.GetAffiliation() != "College of Engineering" || a.GetAuthorName() != "Juan Dela Cruz" || a.GetVersion() != 2 {
+		t.Fatalf("updated %v", a)
+	}
+
+	_, err = ts.UpdateAuthor(ctx, &pb.UpdateAuthorRequest{
+		Author: &pb.Author{AuthorId: id, AuthorName: "Juan", Version: 1},
+	})
+	wantCode(t, err, codes.Aborted)
+	_, err = ts.DeleteAuthor(ctx, &pb.DeleteAuthorRequest{AuthorId: id, Version: 1})
+	wantCode(t, err, codes.Aborted)
+
+	masked, err := ts.UpdateAuthor(ctx, &pb.UpdateAuthorRequest{
+		Author:     &pb.Author{AuthorId: id, AuthorName: "Juan"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := masked.GetAuthor(); a.GetEmail() != "" || a.GetAuthorName() != "Juan Dela Cruz" || a.GetAffiliation() != "College of Engineering" {
+		t.Fatalf("updated %v, want only the email cleared", a)
+	}
+	_, err = ts.UpdateAuthor(ctx, &pb.UpdateAuthorRequest{
+		Author:     &pb.Author{AuthorId: id},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"author_id"}},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+
+	if _, err := ts.DeleteAuthor(ctx, &pb.DeleteAuthorRequest{AuthorId: id, Version: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ts.GetAuthor(ctx, &pb.ReadAuthorRequest{AuthorId: id}); err == nil {
+		t.Fatal("read a deleted author")
+	}
+}
+
+func TestPublicationDates(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as(roleAdmin)
+	published := &date.Date{Year: 2021, Month: 3, Day: 15}
+	created, err := call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield", DatePublished: published},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := call(ctx, ts, "GetPublication", (*server).GetPublication, &pb.ReadPublicationRequest{PublicationId: created.GetPublication().GetPublicationId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := read.GetPublication().GetDatePublished(); !proto.Equal(got, published) {
+		t.Fatalf("read date %v, want %v", got, published)
+	}
+
+	for filter, want := range map[string]int{
+		"date_published >= 2021-03-01": 1,
+		"date_published < 2021-01-01":  0,
+	} {
+		found, err := call(ctx, ts, "GetPublications", (*server).GetPublications, &pb.ReadPublicationsRequest{Filter: filter})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found.GetPublications()) != want {
+			t.Errorf("filter %q found %d publications, want %d", filter, len(found.GetPublications()), want)
+		}
+	}
+	_, err = call(ctx, ts, "GetPublications", (*server).GetPublications, &pb.ReadPublicationsRequest{Filter: "date_published >= March"})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestPartialDatesRoundTrip(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as(roleAdmin)
+	created, err := call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield", DatePublished: &date.Date{Year: 2021}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetPublication().GetPublicationId()
+	if got := created.GetPublication().GetDatePublished(); !proto.Equal(got, &date.Date{Year: 2021}) {
+		t.Fatalf("created with date %v, want the year only", got)
+	}
+
+	updated, err := call(ctx, ts, "UpdatePublication", (*server).UpdatePublication, &pb.UpdatePublicationRequest{
+		Publication: &pb.Publication{PublicationId: id, DatePublished: &date.Date{Year: 2021, Month: 3}},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"date_published"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := updated.GetPublication().GetDatePublished(); !proto.Equal(got, &date.Date{Year: 2021, Month: 3}) {
+		t.Fatalf("updated to date %v, want the month only", got)
+	}
+
+	csv, err := export(ts, ctx, &pb.ExportRecordsRequest{Entity: entityPublication, Format: "csv"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(csv, ",2021-03,") {
+		t.Errorf("exported CSV:\n%s", csv)
+	}
+	found, err := call(ctx, ts, "GetPublications", (*server).GetPublications, &pb.ReadPublicationsRequest{Filter: "date_published >= 2021-03-01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found.GetPublications()) != 1 {
+		t.Errorf("filtering by date found %v", found.GetPublications())
+	}
+}
+
+func TestListPagination(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		if _, err := ts.CreateAuthor(ctx, &pb.CreateAuthorRequest{Author: &pb.Author{AuthorName: fmt.Sprintf("Author %d", i)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var names []string
+	req := &pb.ReadAuthorsRequest{PageSize: 2, IncludeTotalSize: true}
+	for pages := 1; ; pages++ {
+		res, err := ts.GetAuthors(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.GetTotalSize() != 5 {
+			t.Fatalf("total size %d, want 5", res.GetTotalSize())
+		}
+		for _, a := range res.GetAuthors() {
+			names = append(names, a.GetAuthorName())
+		}
+		if res.GetNextPageToken() == "" {
+			if pages != 3 {
+				t.Fatalf("listed %d pages, want 3", pages)
+			}
+			break
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+	sort.Strings(names)
+	if fmt.Sprint(names) != "[Author 1 Author 2 Author 3 Author 4 Author 5]" {
+		t.Fatalf("listed %v", names)
+	}
+
+	if _, err := ts.GetAuthors(ctx, &pb.ReadAuthorsRequest{PageToken: "not a token"}); err == nil {
+		t.Fatal("listed with an invalid page token")
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		affiliation := "CICS"
+		if i%2 == 0 {
+			affiliation = "CEAFA"
+		}
+		if _, err := ts.CreateAuthor(ctx, &pb.CreateAuthorRequest{
+			Author: &pb.Author{AuthorName: fmt.Sprintf("Author %d", i), Affiliation: affiliation},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res, err := ts.GetAuthors(ctx, &pb.ReadAuthorsRequest{Filter: `affiliation = CICS AND NOT author_name = "Author 3"`, OrderBy: "author_name desc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, a := range res.GetAuthors() {
+		names = append(names, a.GetAuthorName())
+	}
+	if fmt.Sprint(names) != "[Author 5 Author 1]" {
+		t.Fatalf("filtered %v", names)
+	}
+
+	page, err := ts.GetAuthors(ctx, &pb.ReadAuthorsRequest{PageSize: 1, OrderBy: "author_name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ts.GetAuthors(ctx, &pb.ReadAuthorsRequest{Filter: "affiliation = CICS", OrderBy: "author_name", PageToken: page.GetNextPageToken()}); err == nil {
+		t.Error("listed with the page token of another filter")
+	}
+	if _, err := ts.GetAuthors(ctx, &pb.ReadAuthorsRequest{Filter: "password = x"}); err == nil {
+		t.Error("filtered by a field that cannot be filtered")
+	}
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Migrations live in migrations/ as NNNN_name.up.sql and NNNN_name.down.sql
+// pairs. They are applied in version order and recorded in schema_migrations.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+type migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+type schemaMigration struct {
+	Version   int `gorm:"primarykey"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// migrationLockID is the advisory lock key that serializes servers migrating
+// the same database at startup.
+const migrationLockID = 7283401
+
+func loadMigrations() ([]migration, error) {
+	files, err := fs.Glob(migrationFiles, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int]*migration{}
+	for _, file := range files {
+		base := path.Base(file)
+		stem, direction, ok := strings.Cut(strings.TrimSuffix(base, ".sql"), ".")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("migration %s: name must end in .up.sql or .down.sql", base)
+		}
+		prefix, name, ok := strings.Cut(stem, "_")
+		version, err := strconv.Atoi(prefix)
+		if !ok || err != nil {
+			return nil, fmt.Errorf("migration %s: name must start with a version number", base)
+		}
+		body, err := migrationFiles.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		m := byVersion[version]
+		if m == nil {
+			m = &migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, m.Name, name)
+		}
+		if direction == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+type migrator struct {
+	db         *gorm.DB
+	migrations []migration
+}
+
+func newMigrator(db *gorm.DB) (*migrator, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	err = db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    integer PRIMARY KEY,
+		name       text NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`).Error
+	if err != nil {
+		return nil, fmt.Errorf("creating schema_migrations: %w", err)
+	}
+	return &migrator{db: db, migrations: migrations}, nil
+}
+
+func (m *migrator) applied(tx *gorm.DB) (map[int]schemaMigration, error) {
+	var rows []schemaMigration
+	if err := tx.Order("version").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	applied := map[int]schemaMigration{}
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+// Up applies every pending migration, each in its own transaction, and
+// returns the migrations it applied.
+func (m *migrator) Up() ([]migration, error) {
+	var done []migration
+	for _, mig := range m.migrations {
+		ran := false
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockID).Error; err != nil {
+				return err
+			}
+			applied, err := m.applied(tx)
+			if err != nil {
+				return err
+			}
+			if _, ok := applied[mig.Version]; ok {
+				return nil
+			}
+			if err := tx.Exec(mig.Up).Error; err != nil {
+				return err
+			}
+			ran = true
+			return tx.Create(&schemaMigration{Version: mig.Version, Name: mig.Name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("migration %04d_%s up: %w", mig.Version, mig.Name, err)
+		}
+		if ran {
+			done = append(done, mig)
+		}
+	}
+	return done, nil
+}
+
+// Down rolls back the most recently applied migration. It returns nil when
+// there is nothing to roll back.
+func (m *migrator) Down() (*migration, error) {
+	var undone *migration
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockID).Error; err != nil {
+			return err
+		}
+		applied, err := m.applied(tx)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+			if err := tx.Exec(mig.Down).Error; err != nil {
+				return fmt.Errorf("migration %04d_%s down: %w", mig.Version, mig.Name, err)
+			}
+			undone = &mig
+			return tx.Delete(&schemaMigration{}, mig.Version).Error
+		}
+		return nil
+	})
+	return undone, err
+}
+
+// Status writes one line per known migration with the time it was applied.
+func (m *migrator) Status(w io.Writer) error {
+	applied, err := m.applied(m.db)
+	if err != nil {
+		return err
+	}
+	for _, mig := range m.migrations {
+		state := "pending"
+		if row, ok := applied[mig.Version]; ok {
+			state = "applied " + row.AppliedAt.Format(time.RFC3339)
+			delete(applied, mig.Version)
+		}
+		fmt.Fprintf(w, "%04d_%-40s %s\n", mig.Version, mig.Name, state)
+	}
+	for _, row := range applied {
+		fmt.Fprintf(w, "%04d_%-40s applied %s (missing from this build)\n", row.Version, row.Name, row.AppliedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// typedDatesMigration typed the date columns. It keeps the values it could
+// not parse in date_migration_issues.
+const typedDatesMigration = 9
+
+type dateMigrationIssue struct {
+	TableName  string
+	RowKey     string
+	ColumnName string
+	Value      string
+}
+
+// UnparsedDates writes the values the typed dates migration could not parse
+// and left unset, one per line, so that they can be fixed by hand.
+func (m *migrator) UnparsedDates(w io.Writer) error {
+	var issues []dateMigrationIssue
+	err := m.db.Table("date_migration_issues").Order("table_name, column_name, row_key").Find(&issues).Error
+	if err != nil {
+		return fmt.Errorf("reading date_migration_issues, which migration %04d creates: %w", typedDatesMigration, err)
+	}
+	for _, issue := range issues {
+		fmt.Fprintf(w, "%s %s %s: %q\n", issue.TableName, issue.RowKey, issue.ColumnName, issue.Value)
+	}
+	fmt.Fprintf(w, "%d values could not be parsed as dates\n", len(issues))
+	return nil
+}
+
+// unparsedDatesNote returns a note on the values the typed dates migration
+// could not parse when it is among done, or "" when there are none.
+func (m *migrator) unparsedDatesNote(done []migration) string {
+	for _, mig := range done {
+		if mig.Version != typedDatesMigration {
+			continue
+		}
+		var n int64
+		if err := m.db.Table("date_migration_issues").Count(&n).Error; err != nil || n == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%d values could not be parsed as dates and were cleared; list them with: server migrate dates", n)
+	}
+	return ""
+}
+
+// runMigrate implements the "migrate up|down|status|dates" subcommand.
+func runMigrate(cfg Config, args []string, w io.Writer) error {
+	if len(args) != 1 {
+		return errors.New("usage: server [flags] migrate up|down|status|dates")
+	}
+	db, err := DatabaseConnection(cfg.Database)
+	if err != nil {
+		return err
+	}
+	m, err := newMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		done, err := m.Up()
+		for _, mig := range done {
+			fmt.Fprintf(w, "Applied %04d_%s\n", mig.Version, mig.Name)
+		}
+		if note := m.unparsedDatesNote(done); note != "" {
+			fmt.Fprintln(w, note)
+		}
+		if err == nil && len(done) == 0 {
+			fmt.Fprintln(w, "Database is up to date")
+		}
+		return err
+	case "down":
+		mig, err := m.Down()
+		if err == nil && mig == nil {
+			fmt.Fprintln(w, "No migrations to roll back")
+		} else if mig != nil {
+			fmt.Fprintf(w, "Rolled back %04d_%s\n", mig.Version, mig.Name)
+		}
+		return err
+	case "status":
+		return m.Status(w)
+	case "dates":
+		return m.UnparsedDates(w)
+	}
+	return fmt.Errorf("unknown migrate command %q: want up, down, status or dates", args[0])
+}
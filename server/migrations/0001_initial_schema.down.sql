@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS table_log;
+DROP TABLE IF EXISTS table_user;
+DROP TABLE IF EXISTS table_publications;
+DROP TABLE IF EXISTS table_ipassets;
+DROP TABLE IF EXISTS table_authors;
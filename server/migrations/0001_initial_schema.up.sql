@@ -0,0 +1,78 @@
+-- Tables as queried by the RMS handlers. IF NOT EXISTS lets databases whose
+-- tables were created by hand adopt the migration history.
+
+CREATE TABLE IF NOT EXISTS table_authors (
+    author_id      text PRIMARY KEY,
+    author_name    text NOT NULL DEFAULT '',
+    gender         text NOT NULL DEFAULT '',
+    type_of_author text NOT NULL DEFAULT '',
+    affiliation    text NOT NULL DEFAULT '',
+    email          text NOT NULL DEFAULT '',
+    created_at     timestamptz NOT NULL DEFAULT now(),
+    updated_at     timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS table_ipassets (
+    registration_number text PRIMARY KEY,
+    title_of_work       text NOT NULL DEFAULT '',
+    type_of_document    text NOT NULL DEFAULT '',
+    class_of_work       text NOT NULL DEFAULT '',
+    date_of_creation    text NOT NULL DEFAULT '',
+    date_registered     text NOT NULL DEFAULT '',
+    campus              text NOT NULL DEFAULT '',
+    college             text NOT NULL DEFAULT '',
+    program             text NOT NULL DEFAULT '',
+    authors             text NOT NULL DEFAULT '',
+    hyperlink           text NOT NULL DEFAULT '',
+    status              text NOT NULL DEFAULT '',
+    certificate         text NOT NULL DEFAULT '',
+    created_at          timestamptz NOT NULL DEFAULT now(),
+    updated_at          timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS table_publications (
+    publication_id         text PRIMARY KEY,
+    date_published         text NOT NULL DEFAULT '',
+    quartile               text NOT NULL DEFAULT '',
+    authors                text NOT NULL DEFAULT '',
+    department             text NOT NULL DEFAULT '',
+    college                text NOT NULL DEFAULT '',
+    campus                 text NOT NULL DEFAULT '',
+    title_of_paper         text NOT NULL DEFAULT '',
+    type_of_publication    text NOT NULL DEFAULT '',
+    funding_source         text NOT NULL DEFAULT '',
+    number_of_citation     integer NOT NULL DEFAULT 0,
+    google_scholar_details text NOT NULL DEFAULT '',
+    sdg_no                 text NOT NULL DEFAULT '',
+    funding_type           text NOT NULL DEFAULT '',
+    nature_of_funding      text NOT NULL DEFAULT '',
+    publisher              text NOT NULL DEFAULT '',
+    abstract               text NOT NULL DEFAULT '',
+    created_at             timestamptz NOT NULL DEFAULT now(),
+    updated_at             timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS table_user (
+    user_id      integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    sr_code      text NOT NULL DEFAULT '',
+    email        text NOT NULL DEFAULT '',
+    password     text NOT NULL DEFAULT '',
+    account_type text NOT NULL DEFAULT '',
+    user_contact text NOT NULL DEFAULT '',
+    user_img     text NOT NULL DEFAULT '',
+    user_fname   text NOT NULL DEFAULT '',
+    user_lname   text NOT NULL DEFAULT '',
+    user_mname   text NOT NULL DEFAULT '',
+    created_at   timestamptz NOT NULL DEFAULT now(),
+    updated_at   timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS table_log (
+    log_id      text PRIMARY KEY,
+    date_time   text NOT NULL DEFAULT '',
+    user_id     integer NOT NULL DEFAULT 0,
+    activity    text NOT NULL DEFAULT '',
+    description text NOT NULL DEFAULT '',
+    created_at  timestamptz NOT NULL DEFAULT now(),
+    updated_at  timestamptz NOT NULL DEFAULT now()
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS table_ipassets_search_idx;
+ALTER TABLE table_ipassets DROP COLUMN IF EXISTS search_vector;
+
+DROP INDEX IF EXISTS table_publications_search_idx;
+ALTER TABLE table_publications DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,19 @@
+-- Weighted search documents; the weights order title matches above author,
+-- publisher and abstract matches in ts_rank.
+
+ALTER TABLE table_publications ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(title_of_paper, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(authors, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(publisher, '')), 'C') ||
+        setweight(to_tsvector('english', coalesce(abstract, '')), 'D')
+    ) STORED;
+
+CREATE INDEX table_publications_search_idx ON table_publications USING GIN (search_vector);
+
+ALTER TABLE table_ipassets ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(title_of_work, '')), 'A')
+    ) STORED;
+
+CREATE INDEX table_ipassets_search_idx ON table_ipassets USING GIN (search_vector);
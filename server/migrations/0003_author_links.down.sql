@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS table_ipasset_authors;
+DROP TABLE IF EXISTS table_publication_authors;
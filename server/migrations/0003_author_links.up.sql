@@ -0,0 +1,19 @@
+CREATE TABLE table_publication_authors (
+    publication_id text NOT NULL REFERENCES table_publications (publication_id) ON DELETE CASCADE,
+    author_id      text NOT NULL REFERENCES table_authors (author_id) ON DELETE CASCADE,
+    author_order   integer NOT NULL,
+    role           text NOT NULL CHECK (role IN ('main_author', 'co_author', 'inventor')),
+    PRIMARY KEY (publication_id, author_id)
+);
+
+CREATE INDEX table_publication_authors_author_idx ON table_publication_authors (author_id);
+
+CREATE TABLE table_ipasset_authors (
+    registration_number text NOT NULL REFERENCES table_ipassets (registration_number) ON DELETE CASCADE,
+    author_id           text NOT NULL REFERENCES table_authors (author_id) ON DELETE CASCADE,
+    author_order        integer NOT NULL,
+    role                text NOT NULL CHECK (role IN ('main_author', 'co_author', 'inventor')),
+    PRIMARY KEY (registration_number, author_id)
+);
+
+CREATE INDEX table_ipasset_authors_author_idx ON table_ipasset_authors (author_id);
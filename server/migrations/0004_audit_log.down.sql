@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS table_log_user_idx;
+DROP INDEX IF EXISTS table_log_entity_idx;
+
+ALTER TABLE table_log
+    DROP COLUMN changes,
+    DROP COLUMN entity_id,
+    DROP COLUMN entity,
+    DROP COLUMN method;
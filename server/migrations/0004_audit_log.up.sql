@@ -0,0 +1,8 @@
+ALTER TABLE table_log
+    ADD COLUMN method    text NOT NULL DEFAULT '',
+    ADD COLUMN entity    text NOT NULL DEFAULT '',
+    ADD COLUMN entity_id text NOT NULL DEFAULT '',
+    ADD COLUMN changes   jsonb NOT NULL DEFAULT '{}';
+
+CREATE INDEX table_log_entity_idx ON table_log (entity, entity_id);
+CREATE INDEX table_log_user_idx ON table_log (user_id);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS table_log_seq_idx;
+
+ALTER TABLE table_log
+    DROP COLUMN hash,
+    DROP COLUMN prev_hash,
+    DROP COLUMN seq;
+
+ALTER TABLE table_log ALTER COLUMN changes DROP DEFAULT;
+ALTER TABLE table_log ALTER COLUMN changes TYPE jsonb USING changes::jsonb;
+ALTER TABLE table_log ALTER COLUMN changes SET DEFAULT '{}';
@@ -0,0 +1,35 @@
+-- jsonb rewrites the stored text, which would change the hashed contents.
+ALTER TABLE table_log ALTER COLUMN changes DROP DEFAULT;
+ALTER TABLE table_log ALTER COLUMN changes TYPE text USING changes::text;
+ALTER TABLE table_log ALTER COLUMN changes SET DEFAULT '{}';
+
+ALTER TABLE table_log
+    ADD COLUMN seq       bigint,
+    ADD COLUMN prev_hash text NOT NULL DEFAULT '',
+    ADD COLUMN hash      text NOT NULL DEFAULT '';
+
+-- Chain the existing rows in the order they were written. The hash input
+-- must match logHash in server/logchain.go.
+DO $$
+DECLARE
+    r    record;
+    n    bigint := 0;
+    prev text := '';
+    body text;
+BEGIN
+    FOR r IN SELECT * FROM table_log ORDER BY created_at, log_id LOOP
+        n := n + 1;
+        SELECT string_agg(octet_length(f) || ':' || f || chr(10), '' ORDER BY i) INTO body
+        FROM unnest(ARRAY[
+            n::text, prev, r.log_id, r.date_time, r.user_id::text, r.activity,
+            r.description, r.method, r.entity, r.entity_id, r.changes
+        ]) WITH ORDINALITY AS t(f, i);
+        UPDATE table_log
+        SET seq = n, prev_hash = prev, hash = encode(sha256(convert_to(body, 'UTF8')), 'hex')
+        WHERE log_id = r.log_id
+        RETURNING hash INTO prev;
+    END LOOP;
+END $$;
+
+ALTER TABLE table_log ALTER COLUMN seq SET NOT NULL;
+CREATE UNIQUE INDEX table_log_seq_idx ON table_log (seq);
@@ -0,0 +1,12 @@
+-- Without deleted_at, trashed rows would come back as live ones.
+DELETE FROM table_publications WHERE deleted_at IS NOT NULL;
+DELETE FROM table_ipassets WHERE deleted_at IS NOT NULL;
+DELETE FROM table_authors WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS table_publications_deleted_idx;
+DROP INDEX IF EXISTS table_ipassets_deleted_idx;
+DROP INDEX IF EXISTS table_authors_deleted_idx;
+
+ALTER TABLE table_publications DROP COLUMN deleted_at;
+ALTER TABLE table_ipassets DROP COLUMN deleted_at;
+ALTER TABLE table_authors DROP COLUMN deleted_at;
@@ -0,0 +1,7 @@
+ALTER TABLE table_authors ADD COLUMN deleted_at timestamptz;
+ALTER TABLE table_ipassets ADD COLUMN deleted_at timestamptz;
+ALTER TABLE table_publications ADD COLUMN deleted_at timestamptz;
+
+CREATE INDEX table_authors_deleted_idx ON table_authors (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX table_ipassets_deleted_idx ON table_ipassets (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX table_publications_deleted_idx ON table_publications (deleted_at) WHERE deleted_at IS NOT NULL;
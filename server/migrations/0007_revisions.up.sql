@@ -0,0 +1,10 @@
+CREATE TABLE table_revisions (
+    entity     text NOT NULL CHECK (entity IN ('author', 'ip_asset', 'publication')),
+    entity_id  text NOT NULL,
+    revision   integer NOT NULL,
+    user_id    integer NOT NULL DEFAULT 0,
+    method     text NOT NULL DEFAULT '',
+    data       jsonb NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (entity, entity_id, revision)
+);
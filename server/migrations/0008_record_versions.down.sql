@@ -0,0 +1,4 @@
+ALTER TABLE table_user DROP COLUMN version;
+ALTER TABLE table_publications DROP COLUMN version;
+ALTER TABLE table_ipassets DROP COLUMN version;
+ALTER TABLE table_authors DROP COLUMN version;
@@ -0,0 +1,4 @@
+ALTER TABLE table_authors ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE table_ipassets ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE table_publications ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE table_user ADD COLUMN version bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,85 @@
+-- Dates go back to ISO 8601 text. Values the up migration could not parse
+-- get their original text back unless they were set since.
+DO $$
+DECLARE
+    f record;
+BEGIN
+    FOR f IN SELECT * FROM (VALUES
+        ('ip_asset', 'date_of_creation'),
+        ('ip_asset', 'date_registered'),
+        ('publication', 'date_published')
+    ) AS t(entity, field) LOOP
+        UPDATE table_revisions
+        SET data = jsonb_set(data, ARRAY[f.field], to_jsonb(to_char(make_date(
+            (data->f.field->>'year')::int,
+            COALESCE((data->f.field->>'month')::int, 1),
+            COALESCE((data->f.field->>'day')::int, 1)
+        ), CASE
+            WHEN data->f.field ? 'day' THEN 'YYYY-MM-DD'
+            WHEN data->f.field ? 'month' THEN 'YYYY-MM'
+            ELSE 'YYYY'
+        END)))
+        WHERE entity = f.entity AND jsonb_typeof(data->f.field) = 'object';
+
+        UPDATE table_revisions r
+        SET data = jsonb_set(r.data, ARRAY[f.field], to_jsonb(i.value))
+        FROM date_migration_issues i
+        WHERE r.entity = f.entity AND r.data->f.field IS NULL
+            AND i.table_name = 'table_revisions' AND i.column_name = f.field
+            AND i.row_key = r.entity_id || '/' || r.revision;
+    END LOOP;
+END $$;
+
+ALTER TABLE table_log
+    ALTER COLUMN date_time TYPE text USING CASE
+        WHEN date_time_text <> '' THEN date_time_text
+        ELSE COALESCE(to_char(date_time AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'), '')
+    END,
+    ALTER COLUMN date_time SET DEFAULT '',
+    ALTER COLUMN date_time SET NOT NULL;
+ALTER TABLE table_log DROP COLUMN date_time_text;
+
+-- Partial dates go back to text of their precision, e.g. "2021-03".
+CREATE FUNCTION rms_date_text(d date, p text) RETURNS text
+LANGUAGE sql AS $$
+    SELECT COALESCE(to_char(d, CASE p WHEN 'year' THEN 'YYYY' WHEN 'month' THEN 'YYYY-MM' ELSE 'YYYY-MM-DD' END), '')
+$$;
+
+ALTER TABLE table_publications
+    ALTER COLUMN date_published TYPE text USING rms_date_text(date_published, date_published_precision),
+    ALTER COLUMN date_published SET DEFAULT '',
+    ALTER COLUMN date_published SET NOT NULL;
+ALTER TABLE table_publications DROP COLUMN date_published_precision;
+
+ALTER TABLE table_ipassets
+    ALTER COLUMN date_of_creation TYPE text USING rms_date_text(date_of_creation, date_of_creation_precision),
+    ALTER COLUMN date_of_creation SET DEFAULT '',
+    ALTER COLUMN date_of_creation SET NOT NULL,
+    ALTER COLUMN date_registered TYPE text USING rms_date_text(date_registered, date_registered_precision),
+    ALTER COLUMN date_registered SET DEFAULT '',
+    ALTER COLUMN date_registered SET NOT NULL;
+ALTER TABLE table_ipassets
+    DROP COLUMN date_of_creation_precision,
+    DROP COLUMN date_registered_precision;
+
+DROP FUNCTION rms_date_text(date, text);
+
+UPDATE table_ipassets t
+SET date_of_creation = i.value
+FROM date_migration_issues i
+WHERE t.date_of_creation = '' AND i.table_name = 'table_ipassets'
+    AND i.column_name = 'date_of_creation' AND i.row_key = t.registration_number;
+
+UPDATE table_ipassets t
+SET date_registered = i.value
+FROM date_migration_issues i
+WHERE t.date_registered = '' AND i.table_name = 'table_ipassets'
+    AND i.column_name = 'date_registered' AND i.row_key = t.registration_number;
+
+UPDATE table_publications t
+SET date_published = i.value
+FROM date_migration_issues i
+WHERE t.date_published = '' AND i.table_name = 'table_publications'
+    AND i.column_name = 'date_published' AND i.row_key = t.publication_id;
+
+DROP TABLE date_migration_issues;
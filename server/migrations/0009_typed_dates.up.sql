@@ -0,0 +1,177 @@
+-- Type the free-form date columns. Values that cannot be parsed become NULL
+-- and are kept in date_migration_issues, which "server migrate dates" lists
+-- and the down migration restores them from.
+CREATE TABLE date_migration_issues (
+    table_name  text NOT NULL,
+    row_key     text NOT NULL,
+    column_name text NOT NULL,
+    value       text NOT NULL,
+    PRIMARY KEY (table_name, row_key, column_name)
+);
+
+-- rms_parse_date reads the formats found in existing rows: ISO 8601 dates,
+-- possibly followed by a time or only to the month or year, slashed dates
+-- with the year first or last (then month first), and dates with English
+-- month names such as "March 5, 2021", "5 Mar 2021" or "March 2021".
+CREATE FUNCTION rms_parse_date(s text) RETURNS date
+LANGUAGE plpgsql AS $$
+DECLARE
+    v      text := btrim(s);
+    m      text[];
+    months text[] := ARRAY['jan', 'feb', 'mar', 'apr', 'may', 'jun', 'jul', 'aug', 'sep', 'oct', 'nov', 'dec'];
+BEGIN
+    IF v ~ '^\d{4}-\d{1,2}-\d{1,2}([ T].*)?$' THEN
+        m := regexp_match(v, '^(\d{4})-(\d{1,2})-(\d{1,2})');
+        RETURN make_date(m[1]::int, m[2]::int, m[3]::int);
+    ELSIF v ~ '^\d{4}-\d{1,2}$' THEN
+        m := regexp_match(v, '^(\d{4})-(\d{1,2})$');
+        RETURN make_date(m[1]::int, m[2]::int, 1);
+    ELSIF v ~ '^\d{4}$' THEN
+        RETURN make_date(v::int, 1, 1);
+    ELSIF v ~ '^\d{4}/\d{1,2}/\d{1,2}$' THEN
+        m := regexp_match(v, '^(\d{4})/(\d{1,2})/(\d{1,2})$');
+        RETURN make_date(m[1]::int, m[2]::int, m[3]::int);
+    ELSIF v ~ '^\d{1,2}/\d{1,2}/\d{4}$' THEN
+        m := regexp_match(v, '^(\d{1,2})/(\d{1,2})/(\d{4})$');
+        RETURN make_date(m[3]::int, m[1]::int, m[2]::int);
+    ELSIF v ~ '^[A-Za-z]{3,}\.?\s+\d{1,2},?\s+\d{4}$' THEN
+        m := regexp_match(v, '^([A-Za-z]{3,})\.?\s+(\d{1,2}),?\s+(\d{4})$');
+        RETURN make_date(m[3]::int, array_position(months, lower(left(m[1], 3))), m[2]::int);
+    ELSIF v ~ '^\d{1,2}\s+[A-Za-z]{3,}\.?,?\s+\d{4}$' THEN
+        m := regexp_match(v, '^(\d{1,2})\s+([A-Za-z]{3,})\.?,?\s+(\d{4})$');
+        RETURN make_date(m[3]::int, array_position(months, lower(left(m[2], 3))), m[1]::int);
+    ELSIF v ~ '^[A-Za-z]{3,}\.?,?\s+\d{4}$' THEN
+        m := regexp_match(v, '^([A-Za-z]{3,})\.?,?\s+(\d{4})$');
+        RETURN make_date(m[2]::int, array_position(months, lower(left(m[1], 3))), 1);
+    END IF;
+    RETURN NULL;
+EXCEPTION WHEN others THEN
+    -- make_date rejects out of range values, including unknown month names.
+    RETURN NULL;
+END $$;
+
+-- rms_date_precision returns how much of a date rms_parse_date reads is
+-- known: 'year' or 'month' for the partial formats, which it reads as their
+-- first day, and 'day' otherwise.
+CREATE FUNCTION rms_date_precision(s text) RETURNS text
+LANGUAGE sql AS $$
+    SELECT CASE
+        WHEN btrim(s) ~ '^\d{4}$' THEN 'year'
+        WHEN btrim(s) ~ '^\d{4}-\d{1,2}$' OR btrim(s) ~ '^[A-Za-z]{3,}\.?,?\s+\d{4}$' THEN 'month'
+        ELSE 'day'
+    END
+$$;
+
+-- rms_parse_timestamp reads ISO 8601 times, taken to be in UTC when they
+-- have no offset, and the dates rms_parse_date reads as their midnight UTC.
+CREATE FUNCTION rms_parse_timestamp(s text) RETURNS timestamptz
+LANGUAGE plpgsql SET timezone = 'UTC' AS $$
+BEGIN
+    IF btrim(s) ~ '^\d{4}-\d{1,2}-\d{1,2}[ T]\d{1,2}:\d{2}' THEN
+        RETURN btrim(s)::timestamptz;
+    END IF;
+    RETURN rms_parse_date(s)::timestamptz;
+EXCEPTION WHEN others THEN
+    RETURN NULL;
+END $$;
+
+-- rms_date_json returns a date as a google.type.Date in protojson, leaving out
+-- the month and day a partial date does not have.
+CREATE FUNCTION rms_date_json(s text) RETURNS jsonb
+LANGUAGE sql AS $$
+    SELECT jsonb_strip_nulls(jsonb_build_object(
+        'year', extract(year FROM rms_parse_date(s))::int,
+        'month', CASE WHEN rms_date_precision(s) <> 'year' THEN extract(month FROM rms_parse_date(s))::int END,
+        'day', CASE WHEN rms_date_precision(s) = 'day' THEN extract(day FROM rms_parse_date(s))::int END
+    ))
+$$;
+
+INSERT INTO date_migration_issues
+SELECT 'table_ipassets', registration_number, 'date_of_creation', date_of_creation
+FROM table_ipassets
+WHERE btrim(date_of_creation) <> '' AND rms_parse_date(date_of_creation) IS NULL;
+
+INSERT INTO date_migration_issues
+SELECT 'table_ipassets', registration_number, 'date_registered', date_registered
+FROM table_ipassets
+WHERE btrim(date_registered) <> '' AND rms_parse_date(date_registered) IS NULL;
+
+INSERT INTO date_migration_issues
+SELECT 'table_publications', publication_id, 'date_published', date_published
+FROM table_publications
+WHERE btrim(date_published) <> '' AND rms_parse_date(date_published) IS NULL;
+
+INSERT INTO date_migration_issues
+SELECT 'table_log', log_id, 'date_time', date_time
+FROM table_log
+WHERE btrim(date_time) <> '' AND rms_parse_timestamp(date_time) IS NULL;
+
+-- Partial dates are stored as their first day, with their precision kept
+-- alongside while the original text still tells them apart.
+ALTER TABLE table_ipassets
+    ADD COLUMN date_of_creation_precision text NOT NULL DEFAULT 'day',
+    ADD COLUMN date_registered_precision text NOT NULL DEFAULT 'day';
+UPDATE table_ipassets
+SET date_of_creation_precision = rms_date_precision(date_of_creation),
+    date_registered_precision = rms_date_precision(date_registered);
+
+ALTER TABLE table_publications ADD COLUMN date_published_precision text NOT NULL DEFAULT 'day';
+UPDATE table_publications
+SET date_published_precision = rms_date_precision(date_published);
+
+ALTER TABLE table_ipassets
+    ALTER COLUMN date_of_creation DROP DEFAULT,
+    ALTER COLUMN date_of_creation DROP NOT NULL,
+    ALTER COLUMN date_of_creation TYPE date USING rms_parse_date(date_of_creation),
+    ALTER COLUMN date_registered DROP DEFAULT,
+    ALTER COLUMN date_registered DROP NOT NULL,
+    ALTER COLUMN date_registered TYPE date USING rms_parse_date(date_registered);
+
+ALTER TABLE table_publications
+    ALTER COLUMN date_published DROP DEFAULT,
+    ALTER COLUMN date_published DROP NOT NULL,
+    ALTER COLUMN date_published TYPE date USING rms_parse_date(date_published);
+
+-- The hash chain covers the time as RFC 3339 text in UTC, as the server
+-- wrote it. Entries stored as any other text keep it in date_time_text,
+-- which logHash in server/logchain.go hashes instead.
+ALTER TABLE table_log ADD COLUMN date_time_text text NOT NULL DEFAULT '';
+UPDATE table_log
+SET date_time_text = date_time
+WHERE date_time <> COALESCE(to_char(rms_parse_timestamp(date_time) AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'), '');
+
+ALTER TABLE table_log
+    ALTER COLUMN date_time DROP DEFAULT,
+    ALTER COLUMN date_time DROP NOT NULL,
+    ALTER COLUMN date_time TYPE timestamptz USING rms_parse_timestamp(date_time);
+
+-- Revisions hold records as protojson, in which a google.type.Date is an
+-- object. Dates that cannot be parsed are dropped from the revision.
+DO $$
+DECLARE
+    f record;
+BEGIN
+    FOR f IN SELECT * FROM (VALUES
+        ('ip_asset', 'date_of_creation'),
+        ('ip_asset', 'date_registered'),
+        ('publication', 'date_published')
+    ) AS t(entity, field) LOOP
+        INSERT INTO date_migration_issues
+        SELECT 'table_revisions', entity_id || '/' || revision, f.field, data->>f.field
+        FROM table_revisions
+        WHERE entity = f.entity AND jsonb_typeof(data->f.field) = 'string'
+            AND btrim(data->>f.field) <> '' AND rms_parse_date(data->>f.field) IS NULL;
+
+        UPDATE table_revisions
+        SET data = CASE
+            WHEN rms_parse_date(data->>f.field) IS NULL THEN data - f.field
+            ELSE jsonb_set(data, ARRAY[f.field], rms_date_json(data->>f.field))
+        END
+        WHERE entity = f.entity AND jsonb_typeof(data->f.field) = 'string';
+    END LOOP;
+END $$;
+
+DROP FUNCTION rms_date_json(text);
+DROP FUNCTION rms_parse_timestamp(text);
+DROP FUNCTION rms_date_precision(text);
+DROP FUNCTION rms_parse_date(text);
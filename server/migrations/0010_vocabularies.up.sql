@@ -0,0 +1,9 @@
+CREATE TABLE table_vocabulary (
+    vocabulary text NOT NULL,
+    value      text NOT NULL,
+    label      text NOT NULL DEFAULT '',
+    position   integer NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (vocabulary, value)
+);
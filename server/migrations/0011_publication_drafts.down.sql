@@ -0,0 +1 @@
+ALTER TABLE table_publications DROP COLUMN draft;
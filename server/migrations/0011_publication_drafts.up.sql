@@ -0,0 +1 @@
+ALTER TABLE table_publications ADD COLUMN draft boolean NOT NULL DEFAULT false;
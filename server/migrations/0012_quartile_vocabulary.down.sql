@@ -0,0 +1 @@
+DELETE FROM table_vocabulary WHERE vocabulary = 'quartile' AND value IN ('Q1', 'Q2', 'Q3', 'Q4');
@@ -0,0 +1,8 @@
+-- Quartiles were limited to Q1 to Q4 in code; they are now checked against
+-- their vocabulary like the other categorical fields.
+INSERT INTO table_vocabulary (vocabulary, value, label, position) VALUES
+    ('quartile', 'Q1', 'Q1', 1),
+    ('quartile', 'Q2', 'Q2', 2),
+    ('quartile', 'Q3', 'Q3', 3),
+    ('quartile', 'Q4', 'Q4', 4)
+ON CONFLICT (vocabulary, value) DO NOTHING;
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"hash/fnv"
+	"reflect"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+var errInvalidPageToken = invalidArgument("page_token", "invalid page token")
+
+// pageToken is the decoded form of the opaque page_token handed to clients.
+// It carries the sort-key values of the last row on the page and a checksum
+// of the filter and order_by it was issued for.
+type pageToken struct {
+	After []string `json:"a"`
+	Query uint32   `json:"q"`
+}
+
+func encodePageToken(t pageToken) string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(token string) (pageToken, error) {
+	var t pageToken
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return t, errInvalidPageToken
+	}
+	if err := json.Unmarshal(data, &t); err != nil || len(t.After) == 0 {
+		return t, errInvalidPageToken
+	}
+	return t, nil
+}
+
+// listRequest is implemented by every Read*sRequest message.
+type listRequest interface {
+	GetPageSize() int32
+	GetPageToken() string
+	GetFilter() string
+	GetOrderBy() string
+}
+
+// pager holds the store options resolved from a list request, along with
+// what is needed to issue the next page token.
+type pager struct {
+	opts  ListOptions
+	size  int
+	query uint32
+}
+
+// newPager validates the paging, filter and order_by fields of a list
+// request. It asks the store for one row more than the page size so
+// nextPage can tell whether another page follows.
+func newPager(req listRequest, schema *querySchema) (*pager, error) {
+	size := int(req.GetPageSize())
+	switch {
+	case size < 0:
+		return nil, invalidArgument("page_size", "must not be negative")
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	filter, err := parseFilter(req.GetFilter(), schema)
+	if err != nil {
+		return nil, invalidArgument("filter", err.Error())
+	}
+	orderBy, err := parseOrderBy(req.GetOrderBy(), schema)
+	if err != nil {
+		return nil, invalidArgument("order_by", err.Error())
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(req.GetFilter() + "\x00" + req.GetOrderBy()))
+	p := &pager{
+		opts:  ListOptions{Filter: filter, OrderBy: orderBy, Limit: size + 1},
+		size:  size,
+		query: h.Sum32(),
+	}
+
+	if req.GetPageToken() != "" {
+		t, err := decodePageToken(req.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+		if t.Query != p.query || len(t.After) != len(orderBy) {
+			return nil, invalidArgument("page_token", "does not match the filter and order_by of the request")
+		}
+		for i, v := range t.After {
+			after, err := orderBy[i].field.parseValue(v)
+			if err != nil {
+				return nil, errInvalidPageToken
+			}
+			p.opts.After = append(p.opts.After, after)
+		}
+	}
+	return p, nil
+}
+
+// nextPage trims rows to the page size and returns the token for the page
+// after it, or "" when rows ends the listing.
+func nextPage[T any](p *pager, rows []T) ([]T, string) {
+	if len(rows) <= p.size {
+		return rows, ""
+	}
+	rows = rows[:p.size]
+	last := reflect.ValueOf(rows[p.size-1])
+	t := pageToken{Query: p.query}
+	for _, term := range p.opts.OrderBy {
+		t.After = append(t.After, term.field.formatValue(term.field.value(last)))
+	}
+	return rows, encodePageToken(t)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Passwords are stored as bcrypt hashes and never leave the server. Rows
+// written before hashing was introduced still hold the plaintext password;
+// checkPassword accepts such a password once and replaces it with its hash.
+
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func isPasswordHash(stored string) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if strings.HasPrefix(stored, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPassword reports whether password is the user's password. A matching
+// plaintext password is upgraded to a hash in the store.
+func (s *server) checkPassword(ctx context.Context, user *User, password string) (bool, error) {
+	if user.Password == "" || password == "" {
+		return false, nil
+	}
+	if isPasswordHash(user.Password) {
+		err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
+		return err == nil, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(user.Password), []byte(password)) != 1 {
+		return false, nil
+	}
+	hash, err := hashPassword(password)
+	if err != nil {
+		return true, err
+	}
+	if err := s.users.SetUserPassword(ctx, user.UserID, hash); err != nil {
+		return true, err
+	}
+	user.Password = hash
+	return true, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+)
+
+func TestPasswordsAreHashed(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	created, err := ts.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{Email: "juan@example.com", Password: "correct horse"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetUser().GetPassword() != "" {
+		t.Error("CreateUser returned the password")
+	}
+	user, err := ts.store.GetUser(ctx, created.GetUser().GetUserId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isPasswordHash(user.Password) {
+		t.Fatalf("stored password %q, want a bcrypt hash", user.Password)
+	}
+	for password, want := range map[string]bool{"correct horse": true, "battery staple": false, "": false} {
+		if ok, err := ts.checkPassword(ctx, user, password); ok != want || err != nil {
+			t.Errorf("checkPassword(%q) = %v, %v; want %v", password, ok, err, want)
+		}
+	}
+}
+
+func TestPlaintextPasswordIsUpgraded(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	user := &User{Email: "maria@example.com", Password: "correct horse"}
+	if err := ts.store.CreateUser(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := ts.checkPassword(ctx, user, "correct horse"); !ok || err != nil {
+		t.Fatalf("checkPassword = %v, %v; want true", ok, err)
+	}
+	stored, err := ts.store.GetUser(ctx, user.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isPasswordHash(stored.Password) {
+		t.Errorf("a checked plaintext password is still stored as %q", stored.Password)
+	}
+	if stored.Version != user.Version {
+		t.Errorf("upgrading the password changed the version from %d to %d", user.Version, stored.Version)
+	}
+}
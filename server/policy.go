@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Roles are derived from User.AccountType, e.g. "Research Office" is
+// roleResearchOffice.
+const (
+	roleAdmin          = "admin"
+	roleResearchOffice = "research_office"
+	roleFaculty        = "faculty"
+	roleStudent        = "student"
+)
+
+func normalizeRole(accountType string) string {
+	role := strings.ToLower(strings.TrimSpace(accountType))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(role)
+}
+
+// grant is what a role may do with an RPC.
+type grant int
+
+const (
+	denied grant = iota
+	granted
+	// grantedIfLinked allows editing a publication or IP asset the caller is
+	// linked to as an author. Users and authors are matched by email.
+	grantedIfLinked
+	// grantedIfSelf allows reading the caller's own account and log entries.
+	grantedIfSelf
+)
+
+// rule maps roles to their grant for one RPC; missing roles are denied.
+type rule map[string]grant
+
+var (
+	everyone             = rule{roleAdmin: granted, roleResearchOffice: granted, roleFaculty: granted, roleStudent: granted}
+	staff                = rule{roleAdmin: granted, roleResearchOffice: granted}
+	staffOrLinkedFaculty = rule{roleAdmin: granted, roleResearchOffice: granted, roleFaculty: grantedIfLinked}
+	staffOrFaculty       = rule{roleAdmin: granted, roleResearchOffice: granted, roleFaculty: granted}
+	adminOnly            = rule{roleAdmin: granted}
+	adminOrSelf          = rule{roleAdmin: granted, roleResearchOffice: grantedIfSelf, roleFaculty: grantedIfSelf, roleStudent: grantedIfSelf}
+)
+
+// policy maps every RPC, by method name, to the roles that may call it.
+// RPCs missing from policy are denied to every role; publicMethods skip it.
+var policy = map[string]rule{
+	"CreateAuthor": staff,
+	"GetAuthor":    everyone,
+	"GetAuthors":   everyone,
+	"UpdateAuthor": staff,
+	"DeleteAuthor": staff,
+
+	"ListDeletedAuthors": staff,
+	"RestoreAuthor":      staff,
+
+	"CreateIP_Asset": staff,
+	"GetIP_Asset":    everyone,
+	"GetIP_Assets":   everyone,
+	"UpdateIP_Asset": staffOrLinkedFaculty,
+	"DeleteIP_Asset": staff,
+
+	"ListDeletedIP_Assets": staff,
+	"RestoreIP_Asset":      staff,
+
+	"CreatePublication": staff,
+	"GetPublication":    everyone,
+	"GetPublications":   everyone,
+	"UpdatePublication": staffOrLinkedFaculty,
+	"DeletePublication": staff,
+
+	"ListDeletedPublications": staff,
+	"RestorePublication":      staff,
+
+	"CreateUser": adminOnly,
+	"GetUser":    adminOrSelf,
+	"GetUsers":   adminOnly,
+	"UpdateUser": adminOnly,
+	"DeleteUser": adminOnly,
+
+	"CreateLog": adminOnly,
+	"GetLog":    adminOrSelf,
+	"GetLogs":   adminOrSelf,
+	"UpdateLog": adminOnly,
+	"DeleteLog": adminOnly,
+
+	"LinkPublicationAuthor":   staffOrLinkedFaculty,
+	"UnlinkPublicationAuthor": staffOrLinkedFaculty,
+	"LinkIP_AssetAuthor":      staffOrLinkedFaculty,
+	"UnlinkIP_AssetAuthor":    staffOrLinkedFaculty,
+	"ListAuthorPublications":  everyone,
+	"ListAuthorIP_Assets":     everyone,
+
+	"Search": everyone,
+
+	"VerifyLogChain":      adminOnly,
+	"ExportLogCheckpoint": adminOnly,
+
+	"ListRevisions":    everyone,
+	"DiffRevisions":    everyone,
+	"RevertToRevision": staffOrLinkedFaculty,
+
+	"ListVocabulary":           everyone,
+	"CreateVocabularyTerm":     adminOnly,
+	"UpdateVocabularyTerm":     adminOnly,
+	"DeleteVocabularyTerm":     adminOnly,
+	"ListVocabularyViolations": staff,
+
+	// Every request of a batch is authorized as well, as a call of the
+	// single-record RPC, which limits faculty to their linked records.
+	"BatchCreateAuthors":      staff,
+	"BatchUpdateAuthors":      staff,
+	"BatchCreateIP_Assets":    staff,
+	"BatchUpdateIP_Assets":    staffOrFaculty,
+	"BatchCreatePublications": staff,
+	"BatchUpdatePublications": staffOrFaculty,
+	"BatchCreateUsers":        adminOnly,
+	"BatchUpdateUsers":        adminOnly,
+
+	// Imported rows are authorized as well, as creates and updates.
+	"ImportRecords": staff,
+	// Exported pages are authorized as well, as reads of their list RPC.
+	"ExportRecords": everyone,
+	// Cited publications are authorized as reads of GetPublication(s).
+	"ExportCitations": everyone,
+	// Faculty may import their bibliographies, as drafts for staff to review.
+	// The drafts are authorized as part of the import.
+	"ImportCitations": staffOrFaculty,
+}
+
+// allowed looks up the grant of a role for an RPC method name.
+func allowed(method, role string) grant {
+	return policy[method][role]
+}
+
+// editedRecord names the publication or IP asset a request edits.
+func editedRecord(req any) (recordKind, string, bool) {
+	switch r := req.(type) {
+	case *pb.UpdatePublicationRequest:
+		return kindPublication, r.GetPublication().GetPublicationId(), true
+	case *pb.LinkPublicationAuthorRequest:
+		return kindPublication, r.GetPublicationId(), true
+	case *pb.UnlinkPublicationAuthorRequest:
+		return kindPublication, r.GetPublicationId(), true
+	case *pb.UpdateIP_AssetRequest:
+		return kindIPAsset, r.GetIpAsset().GetRegistrationNumber(), true
+	case *pb.LinkIP_AssetAuthorRequest:
+		return kindIPAsset, r.GetRegistrationNumber(), true
+	case *pb.UnlinkIP_AssetAuthorRequest:
+		return kindIPAsset, r.GetRegistrationNumber(), true
+	case *pb.RevertToRevisionRequest:
+		switch r.GetEntity() {
+		case entityPublication:
+			return kindPublication, r.GetEntityId(), true
+		case entityIPAsset:
+			return kindIPAsset, r.GetEntityId(), true
+		}
+	}
+	return "", "", false
+}
+
+// isLinked reports whether the user is linked as an author to the record.
+func (s *server) isLinked(ctx context.Context, user *User, kind recordKind, recordID string) (bool, error) {
+	if user.Email == "" {
+		return false, nil
+	}
+	links, err := s.links.LinkedAuthors(ctx, kind, []string{recordID})
+	if err != nil {
+		return false, err
+	}
+	for _, l := range links[recordID] {
+		if strings.EqualFold(l.Author.AuthorEmail, user.Email) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type selfScopeKey struct{}
+
+// selfScope returns the user a call was limited to by grantedIfSelf.
+func selfScope(ctx context.Context) (int32, bool) {
+	id, ok := ctx.Value(selfScopeKey{}).(int32)
+	return id, ok
+}
+
+func errPermissionDenied(role, method string) error {
+	if role == "" {
+		return status.Errorf(codes.PermissionDenied, "an account without a role may not call %s", method)
+	}
+	return status.Errorf(codes.PermissionDenied, "%s may not call %s", role, method)
+}
+
+// authorize enforces policy. It runs after the authentication interceptor,
+// so every non-public call has a principal. The role is the user's current
+// account type rather than the one in the token, so that changing a user's
+// account type or deleting the user takes effect before the token expires.
+func (s *server) authorize(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if publicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	p, ok := principalFrom(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing access token")
+	}
+	user, err := s.users.GetUser(ctx, p.UserID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.Error(codes.Unauthenticated, "the account of the access token no longer exists")
+	} else if err != nil {
+		return nil, storeError(err, "user", "")
+	}
+	method := path.Base(info.FullMethod)
+	role := normalizeRole(user.AccountType)
+
+	switch allowed(method, role) {
+	case granted:
+	case grantedIfLinked:
+		kind, id, ok := editedRecord(req)
+		if !ok {
+			return nil, errPermissionDenied(role, method)
+		}
+		linked, err := s.isLinked(ctx, user, kind, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "checking authorship unsuccessful")
+		}
+		if !linked {
+			return nil, status.Errorf(codes.PermissionDenied, "%s may only edit records they are linked to as an author", role)
+		}
+	case grantedIfSelf:
+		ctx = context.WithValue(ctx, selfScopeKey{}, p.UserID)
+	default:
+		return nil, errPermissionDenied(role, method)
+	}
+	return handler(ctx, req)
+}
+
+func errNotSelf() error {
+	return status.Errorf(codes.PermissionDenied, "only %s may read other users' records", roleAdmin)
+}
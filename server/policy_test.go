@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestPolicyCoversEveryMethod(t *testing.T) {
+	roles := map[string]bool{roleAdmin: true, roleResearchOffice: true, roleFaculty: true, roleStudent: true}
+	var methods []string
+	for _, m := range pb.RMSService_ServiceDesc.Methods {
+		methods = append(methods, m.MethodName)
+	}
+	for _, m := range pb.RMSService_ServiceDesc.Streams {
+		methods = append(methods, m.StreamName)
+	}
+	for _, method := range methods {
+		if publicMethods["/proto.RMSService/"+method] {
+			continue
+		}
+		r, ok := policy[method]
+		if !ok {
+			t.Errorf("%s has no policy", method)
+		}
+		if r[roleAdmin] != granted {
+			t.Errorf("%s is not granted to %s", method, roleAdmin)
+		}
+	}
+	for method, r := range policy {
+		for role := range r {
+			if !roles[role] {
+				t.Errorf("policy of %s names unknown role %q", method, role)
+			}
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as(roleAdmin)
+	linked, err := call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlinked, err := call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Mangrove Carbon Stocks"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	author, err := call(ctx, ts, "CreateAuthor", (*server).CreateAuthor, &pb.CreateAuthorRequest{
+		Author: &pb.Author{AuthorName: "Juan Dela Cruz", Email: ts.users[roleFaculty].Email},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := call(ctx, ts, "LinkPublicationAuthor", (*server).LinkPublicationAuthor, &pb.LinkPublicationAuthorRequest{
+		PublicationId: linked.GetPublication().GetPublicationId(), AuthorId: author.GetAuthor().GetAuthorId(), AuthorOrder: 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	update := func(p *pb.CreatePublicationResponse) *pb.UpdatePublicationRequest {
+		return &pb.UpdatePublicationRequest{Publication: &pb.Publication{PublicationId: p.GetPublication().GetPublicationId()}}
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		req    any
+		want   codes.Code
+	}{
+		{"public", context.Background(), "Authenticate", &pb.AuthenticateRequest{}, codes.OK},
+		{"no principal", context.Background(), "GetAuthors", &pb.ReadAuthorsRequest{}, codes.Unauthenticated},
+		{"unknown user", withPrincipal(context.Background(), &principal{UserID: 999, Role: "Admin"}), "GetAuthors", &pb.ReadAuthorsRequest{}, codes.Unauthenticated},
+		{"unknown method", ts.as(roleAdmin), "DropTables", nil, codes.PermissionDenied},
+		{"everyone", ts.as(roleStudent), "GetAuthors", &pb.ReadAuthorsRequest{}, codes.OK},
+		{"staff", ts.as(roleResearchOffice), "CreateAuthor", &pb.CreateAuthorRequest{}, codes.OK},
+		{"not staff", ts.as(roleFaculty), "CreateAuthor", &pb.CreateAuthorRequest{}, codes.PermissionDenied},
+		{"admin only", ts.as(roleResearchOffice), "CreateUser", &pb.CreateUserRequest{}, codes.PermissionDenied},
+		{"linked", ts.as(roleFaculty), "UpdatePublication", update(linked), codes.OK},
+		{"not linked", ts.as(roleFaculty), "UpdatePublication", update(unlinked), codes.PermissionDenied},
+		{"linked student", ts.as(roleStudent), "UpdatePublication", update(linked), codes.PermissionDenied},
+		{"self", ts.as(roleStudent), "GetUser", &pb.ReadUserRequest{}, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := &grpc.UnaryServerInfo{FullMethod: "/proto.RMSService/" + tt.method}
+			_, err := ts.authorize(tt.ctx, tt.req, info, func(ctx context.Context, req any) (any, error) {
+				return nil, nil
+			})
+			wantCode(t, err, tt.want)
+		})
+	}
+}
+
+func TestAuthorizeUsesCurrentRole(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as(roleStudent)
+	user := ts.users[roleStudent]
+	user.AccountType = "Research Office"
+	if _, err := ts.store.UpdateUser(context.Background(), user, "AccountType"); err != nil {
+		t.Fatal(err)
+	}
+	_, err := call(ctx, ts, "CreateAuthor", (*server).CreateAuthor, &pb.CreateAuthorRequest{Author: &pb.Author{AuthorName: "Juan Dela Cruz"}})
+	wantCode(t, err, codes.OK)
+
+	user, err = ts.store.GetUser(context.Background(), user.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user.AccountType = "Student"
+	if _, err := ts.store.UpdateUser(context.Background(), user, "AccountType"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = call(ctx, ts, "CreateAuthor", (*server).CreateAuthor, &pb.CreateAuthorRequest{Author: &pb.Author{AuthorName: "Maria Santos"}})
+	wantCode(t, err, codes.PermissionDenied)
+
+	if err := ts.store.DeleteUser(context.Background(), user.UserID, 0); err != nil {
+		t.Fatal(err)
+	}
+	_, err = call(ctx, ts, "GetAuthors", (*server).GetAuthors, &pb.ReadAuthorsRequest{})
+	wantCode(t, err, codes.Unauthenticated)
+}
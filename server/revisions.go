@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Every update of an author, IP asset or publication that changes it saves
+// the whole record as a new revision. Revision 1 of a record is its state
+// before its first update, so every earlier value can be looked up and
+// reverted to.
+
+// Revision is a saved state of an author, IP asset or publication.
+type Revision struct {
+	Entity   string `gorm:"primarykey"`
+	EntityID string `gorm:"primarykey"`
+	Revision int32  `gorm:"primarykey"`
+	UserID   int32
+	Method   string
+	// Data is the record as protojson, without its linked authors.
+	Data      string
+	CreatedAt time.Time `gorm:"autoCreateTime:true"`
+}
+
+// revisable lists the entities that keep revisions.
+var revisable = map[string]bool{
+	entityAuthor:      true,
+	entityIPAsset:     true,
+	entityPublication: true,
+}
+
+func errNotRevisable(entity string) error {
+	return invalidArgument("entity", fmt.Sprintf("%q has no revisions: want %s, %s or %s", entity, entityAuthor, entityIPAsset, entityPublication))
+}
+
+// recordState loads the current state of a record and when it was last
+// updated. Its version is left out: every write changes it, so it is not part
+// of what a revision saves.
+func (s *server) recordState(ctx context.Context, entity, id string) (proto.Message, time.Time, error) {
+	switch entity {
+	case entityAuthor:
+		author, err := s.authors.GetAuthor(ctx, id)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		res := authorToProto(author)
+		res.Version = 0
+		return res, author.UpdatedAt, nil
+	case entityIPAsset:
+		ipAsset, err := s.ipAssets.GetIPAsset(ctx, id)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		res := ipAssetToProto(ipAsset)
+		res.Version = 0
+		return res, ipAsset.UpdatedAt, nil
+	case entityPublication:
+		publication, err := s.publications.GetPublication(ctx, id)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		res := publicationToProto(publication)
+		res.Version = 0
+		return res, publication.UpdatedAt, nil
+	}
+	return nil, time.Time{}, errNotRevisable(entity)
+}
+
+// revisionRecord decodes the record saved in a revision.
+func revisionRecord(rev *Revision) (proto.Message, error) {
+	var m proto.Message
+	switch rev.Entity {
+	case entityAuthor:
+		m = &pb.Author{}
+	case entityIPAsset:
+		m = &pb.IP_Asset{}
+	case entityPublication:
+		m = &pb.Publication{}
+	default:
+		return nil, errNotRevisable(rev.Entity)
+	}
+	if err := protojson.Unmarshal([]byte(rev.Data), m); err != nil {
+		return nil, fmt.Errorf("decoding revision %d of %s %s: %w", rev.Revision, rev.Entity, rev.EntityID, err)
+	}
+	return m, nil
+}
+
+// revisionData encodes a record for a revision. protojson varies its
+// whitespace on purpose, so the output is compacted to keep it stable.
+func revisionData(m proto.Message) (string, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := json.Compact(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// saveRevision saves the current state of a record as its next revision,
+// unless it equals the latest one, and returns the latest revision. before is
+// the state the write started from; it becomes revision 1 of a record
+// without revisions.
+func (s *server) saveRevision(ctx context.Context, method, entity, id string, before proto.Message, beforeAt time.Time) (*Revision, error) {
+	after, _, err := s.recordState(ctx, entity, id)
+	if err != nil {
+		return nil, err
+	}
+	revs, err := s.revisions.ListRevisions(ctx, entity, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(revs) == 0 && before != nil {
+		base := Revision{Entity: entity, EntityID: id, CreatedAt: beforeAt}
+		if base.Data, err = revisionData(before); err != nil {
+			return nil, err
+		}
+		if err := s.revisions.CreateRevision(ctx, &base); err != nil {
+			return nil, err
+		}
+		revs = append(revs, base)
+	}
+	if len(revs) > 0 {
+		latest := &revs[len(revs)-1]
+		if m, err := revisionRecord(latest); err == nil && proto.Equal(m, after) {
+			return latest, nil
+		}
+	}
+
+	rev := Revision{Entity: entity, EntityID: id, Method: method}
+	if p, ok := principalFrom(ctx); ok {
+		rev.UserID = p.UserID
+	}
+	if rev.Data, err = revisionData(after); err != nil {
+		return nil, err
+	}
+	if err := s.revisions.CreateRevision(ctx, &rev); err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+// revise saves a revision of the record an update RPC changed. It runs within
+// the transaction of audit, so a failure to save the revision fails the RPC
+// and rolls back its change.
+func (s *server) revise(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	action, entity, id, ok := mutationTarget(req)
+	if !ok || action != actionUpdate || !revisable[entity] {
+		return handler(ctx, req)
+	}
+	before, beforeAt, _ := s.recordState(ctx, entity, id)
+	res, err := handler(ctx, req)
+	if err != nil {
+		return res, err
+	}
+	if _, err := s.saveRevision(ctx, path.Base(info.FullMethod), entity, id, before, beforeAt); err != nil {
+		return nil, storeError(err, "revision", "")
+	}
+	return res, nil
+}
+
+func (s *server) ListRevisions(ctx context.Context, req *pb.ListRevisionsRequest) (*pb.ListRevisionsResponse, error) {
+	fmt.Println("List Revisions", req.GetEntity(), req.GetEntityId())
+	if !revisable[req.GetEntity()] {
+		return nil, errNotRevisable(req.GetEntity())
+	}
+	revs, err := s.revisions.ListRevisions(ctx, req.GetEntity(), req.GetEntityId())
+	if err != nil {
+		return nil, storeError(err, "revision", "")
+	}
+	res := &pb.ListRevisionsResponse{Revisions: []*pb.Revision{}}
+	for i := range revs {
+		res.Revisions = append(res.Revisions, revisionToProto(&revs[i]))
+	}
+	return res, nil
+}
+
+func (s *server) DiffRevisions(ctx context.Context, req *pb.DiffRevisionsRequest) (*pb.DiffRevisionsResponse, error) {
+	fmt.Println("Diff Revisions", req.GetEntity(), req.GetEntityId(), req.GetFromRevision(), req.GetToRevision())
+	if !revisable[req.GetEntity()] {
+		return nil, errNotRevisable(req.GetEntity())
+	}
+	from, err := s.revisions.GetRevision(ctx, req.GetEntity(), req.GetEntityId(), req.GetFromRevision())
+	if err != nil {
+		return nil, storeError(err, "revision", fmt.Sprint(req.GetFromRevision()))
+	}
+	before, err := revisionRecord(from)
+	if err != nil {
+		return nil, storeError(err, "revision", fmt.Sprint(req.GetFromRevision()))
+	}
+	var after proto.Message
+	if req.GetToRevision() == 0 {
+		if after, _, err = s.recordState(ctx, req.GetEntity(), req.GetEntityId()); err != nil {
+			return nil, storeError(err, req.GetEntity(), req.GetEntityId())
+		}
+	} else {
+		to, err := s.revisions.GetRevision(ctx, req.GetEntity(), req.GetEntityId(), req.GetToRevision())
+		if err != nil {
+			return nil, storeError(err, "revision", fmt.Sprint(req.GetToRevision()))
+		}
+		if after, err = revisionRecord(to); err != nil {
+			return nil, storeError(err, "revision", fmt.Sprint(req.GetToRevision()))
+		}
+	}
+
+	changes := changedFields(before, after)
+	names := make([]string, 0, len(changes))
+	for name := range changes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	res := &pb.DiffRevisionsResponse{Changes: []*pb.FieldChange{}}
+	for _, name := range names {
+		res.Changes = append(res.Changes, &pb.FieldChange{
+			Field:  name,
+			Before: changeValue(changes[name].Before),
+			After:  changeValue(changes[name].After),
+		})
+	}
+	return res, nil
+}
+
+// changeValue formats a field value of a FieldChange: strings as they are,
+// anything else as JSON.
+func changeValue(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	if v == nil {
+		return ""
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func (s *server) RevertToRevision(ctx context.Context, req *pb.RevertToRevisionRequest) (*pb.RevertToRevisionResponse, error) {
+	fmt.Println("Revert To Revision", req.GetEntity(), req.GetEntityId(), req.GetRevision())
+	if !revisable[req.GetEntity()] {
+		return nil, errNotRevisable(req.GetEntity())
+	}
+	rev, err := s.revisions.GetRevision(ctx, req.GetEntity(), req.GetEntityId(), req.GetRevision())
+	if err != nil {
+		return nil, storeError(err, "revision", fmt.Sprint(req.GetRevision()))
+	}
+	m, err := revisionRecord(rev)
+	if err != nil {
+		return nil, storeError(err, "revision", fmt.Sprint(req.GetRevision()))
+	}
+	before, beforeAt, err := s.recordState(ctx, req.GetEntity(), req.GetEntityId())
+	if err != nil {
+		return nil, storeError(err, req.GetEntity(), req.GetEntityId())
+	}
+
+	switch r := m.(type) {
+	case *pb.Author:
+		author := authorFromProto(r)
+		author.ID = req.GetEntityId()
+		_, err = s.authors.UpdateAuthor(ctx, &author, authorFields...)
+	case *pb.IP_Asset:
+		ipAsset := ipAssetFromProto(r)
+		ipAsset.RegistrationNumber = req.GetEntityId()
+		_, err = s.ipAssets.UpdateIPAsset(ctx, &ipAsset, ipAssetFields...)
+	case *pb.Publication:
+		publication := publicationFromProto(r)
+		publication.PublicationID = req.GetEntityId()
+		_, err = s.publications.UpdatePublication(ctx, &publication, publicationFields...)
+	}
+	if err != nil {
+		return nil, storeError(err, req.GetEntity(), req.GetEntityId())
+	}
+	saved, err := s.saveRevision(ctx, "RevertToRevision", req.GetEntity(), req.GetEntityId(), before, beforeAt)
+	if err != nil {
+		return nil, storeError(err, "revision", "")
+	}
+	return &pb.RevertToRevisionResponse{
+		Revision: revisionToProto(saved),
+	}, nil
+}
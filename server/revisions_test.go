@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+)
+
+func TestRevisions(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as("admin")
+
+	created, err := call(ctx, ts, "CreateAuthor", (*server).CreateAuthor, &pb.CreateAuthorRequest{
+		Author: &pb.Author{AuthorName: "Juan Dela Cruz", Affiliation: "CICS"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetAuthor().GetAuthorId()
+	for _, affiliation := range []string{"CEAFA", "COE"} {
+		if _, err := call(ctx, ts, "UpdateAuthor", (*server).UpdateAuthor, &pb.UpdateAuthorRequest{
+			Author: &pb.Author{AuthorId: id, Affiliation: affiliation},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list, err := call(ctx, ts, "ListRevisions", (*server).ListRevisions, &pb.ListRevisionsRequest{Entity: entityAuthor, EntityId: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revs := list.GetRevisions(); len(revs) != 3 || revs[0].GetRevision() != 1 || revs[2].GetMethod() != "UpdateAuthor" {
+		t.Fatalf("revisions %v, want the state before the first update and one per update", revs)
+	}
+
+	diff, err := call(ctx, ts, "DiffRevisions", (*server).DiffRevisions, &pb.DiffRevisionsRequest{Entity: entityAuthor, EntityId: id, FromRevision: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changes := diff.GetChanges(); len(changes) != 1 || changes[0].GetField() != "affiliation" || changes[0].GetBefore() != "CICS" || changes[0].GetAfter() != "COE" {
+		t.Fatalf("changes %v", changes)
+	}
+
+	reverted, err := call(ctx, ts, "RevertToRevision", (*server).RevertToRevision, &pb.RevertToRevisionRequest{Entity: entityAuthor, EntityId: id, Revision: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reverted.GetRevision().GetRevision() != 4 {
+		t.Errorf("revert saved revision %d, want 4", reverted.GetRevision().GetRevision())
+	}
+	read, err := ts.GetAuthor(ctx, &pb.ReadAuthorRequest{AuthorId: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.GetAuthor().GetAffiliation() != "CICS" {
+		t.Errorf("reverted to %v", read.GetAuthor())
+	}
+
+	if _, err := call(ctx, ts, "ListRevisions", (*server).ListRevisions, &pb.ListRevisionsRequest{Entity: "user", EntityId: "1"}); err == nil {
+		t.Error("listed revisions of an entity without them")
+	}
+}
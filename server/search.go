@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	pb "example.com/go-grpc-crud-api/proto"
+)
+
+const (
+	defaultSearchResults = 20
+	maxSearchResults     = 100
+
+	searchKindPublication = "publication"
+	searchKindIPAsset     = "ip_asset"
+
+	snippetRadius = 80
+)
+
+// SearchHit is one ranked search match.
+type SearchHit struct {
+	Kind    string
+	ID      string
+	Title   string
+	Snippet string
+	Rank    float64
+}
+
+func (s *server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	fmt.Println("Search", req.GetQuery())
+	query := strings.TrimSpace(req.GetQuery())
+	if query == "" {
+		return nil, invalidArgument("query", "is required")
+	}
+	limit := int(req.GetPageSize())
+	switch {
+	case limit <= 0:
+		limit = defaultSearchResults
+	case limit > maxSearchResults:
+		limit = maxSearchResults
+	}
+
+	hits, err := s.search.Search(ctx, query, limit)
+	if err != nil {
+		return nil, storeError(err, "search", "")
+	}
+	results := []*pb.SearchResult{}
+	for _, hit := range hits {
+		results = append(results, &pb.SearchResult{
+			Kind:    hit.Kind,
+			Id:      hit.ID,
+			Title:   hit.Title,
+			Snippet: hit.Snippet,
+			Rank:    hit.Rank,
+		})
+	}
+	return &pb.SearchResponse{
+		Results: results,
+	}, nil
+}
+
+// The memory store ranks matches with a plain term count instead of
+// Postgres full-text search. Every query term must appear in the record,
+// and a term found in a heavier field counts for more.
+
+// searchField is a weighted text field of a searchable record.
+type searchField struct {
+	text   string
+	weight float64
+}
+
+func searchTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// matchTerms ranks fields against the query terms. It reports false when a
+// term appears in none of the fields.
+func matchTerms(terms []string, fields []searchField) (float64, bool) {
+	var rank float64
+	for _, term := range terms {
+		var termRank float64
+		for _, f := range fields {
+			termRank += f.weight * float64(strings.Count(strings.ToLower(f.text), term))
+		}
+		if termRank == 0 {
+			return 0, false
+		}
+		rank += termRank
+	}
+	return rank, true
+}
+
+// highlight returns an excerpt of the first field that contains a query term,
+// with every term wrapped in <b></b>.
+func highlight(terms []string, fields []searchField) string {
+	quoted := make([]string, len(terms))
+	for i, t := range terms {
+		quoted[i] = regexp.QuoteMeta(t)
+	}
+	re := regexp.MustCompile(`(?i)` + strings.Join(quoted, "|"))
+
+	for _, f := range fields {
+		loc := re.FindStringIndex(f.text)
+		if loc == nil {
+			continue
+		}
+		start, end := loc[0]-snippetRadius, loc[1]+snippetRadius
+		prefix, suffix := "… ", " …"
+		if start <= 0 {
+			start, prefix = 0, ""
+		}
+		if end >= len(f.text) {
+			end, suffix = len(f.text), ""
+		}
+		for start > 0 && !utf8.RuneStart(f.text[start]) {
+			start--
+		}
+		for end < len(f.text) && !utf8.RuneStart(f.text[end]) {
+			end++
+		}
+		return prefix + re.ReplaceAllString(f.text[start:end], "<b>$0</b>") + suffix
+	}
+	return ""
+}
+
+func publicationSearchFields(p *Publication) []searchField {
+	return []searchField{
+		{p.TitleOfPaper, 1.0},
+		{p.Authors, 0.4},
+		{p.Publisher, 0.2},
+		{p.Abstract, 0.1},
+	}
+}
+
+func ipAssetSearchFields(a *IP_Asset) []searchField {
+	return []searchField{
+		{a.TitleOfWork, 1.0},
+	}
+}
+
+func sortSearchHits(hits []SearchHit, limit int) []SearchHit {
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Rank != hits[j].Rank {
+			return hits[i].Rank > hits[j].Rank
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+)
+
+func TestSearch(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	for _, p := range []*pb.Publication{
+		{TitleOfPaper: "Mangrove Carbon Stocks", Abstract: "Mangroves planted beside rice paddies store more carbon."},
+		{TitleOfPaper: "Deep Learning for Rice Yield"},
+	} {
+		if _, err := ts.CreatePublication(ctx, &pb.CreatePublicationRequest{Publication: p}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res, err := ts.Search(ctx, &pb.SearchRequest{Query: "Rice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hits := res.GetResults()
+	if len(hits) != 2 || hits[0].GetTitle() != "Deep Learning for Rice Yield" || hits[1].GetTitle() != "Mangrove Carbon Stocks" {
+		t.Fatalf("found %v, want the title match first", hits)
+	}
+	if hits[0].GetSnippet() != "Deep Learning for <b>Rice</b> Yield" {
+		t.Errorf("snippet %q", hits[0].GetSnippet())
+	}
+
+	res, err = ts.Search(ctx, &pb.SearchRequest{Query: "rice carbon"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.GetResults()) != 1 || res.GetResults()[0].GetTitle() != "Mangrove Carbon Stocks" {
+		t.Errorf("found %v, want only the record with every term", res.GetResults())
+	}
+
+	if _, err := ts.Search(ctx, &pb.SearchRequest{Query: "  "}); err == nil {
+		t.Error("searched without a query")
+	}
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrNotFound      = errors.New("record not found")
+	ErrAlreadyExists = errors.New("record already exists")
+	ErrStaleVersion  = errors.New("record version is stale")
+)
+
+// Store bundles the per-entity stores the RMS server depends on.
+type Store interface {
+	AuthorStore
+	IPAssetStore
+	PublicationStore
+	UserStore
+	LogStore
+	SearchStore
+	AuthorLinkStore
+	TrashStore
+	RevisionStore
+	VocabularyStore
+	TxStore
+}
+
+// ListOptions selects one page of a filtered, keyset-paginated listing.
+type ListOptions struct {
+	// Filter restricts the rows listed and counted; nil matches every row.
+	Filter filterExpr
+	// OrderBy always ends with the primary key, so the order is total.
+	OrderBy []orderTerm
+	// After holds the OrderBy values of the last row already returned;
+	// only rows sorting after it are listed.
+	After []any
+	// Limit caps the number of rows returned; zero means no limit.
+	Limit int
+	// Deleted lists and counts the trashed authors, IP assets or
+	// publications instead of the live ones.
+	Deleted bool
+}
+
+// Update methods only write non-zero fields of the given record, or exactly
+// the named fields when fields are given, and return the stored record after
+// the update. Every method returns ErrNotFound when the
+// record being read, updated or deleted does not exist.
+//
+// Every write of an author, IP asset, publication or user increments its
+// Version. Update methods read the version the caller expects from the
+// record's Version field and Delete methods take it as an argument; a
+// non-zero expected version that differs from the stored one fails with
+// ErrStaleVersion and writes nothing.
+//
+// Deleting an author, IP asset or publication moves it to the trash. Trashed
+// records are invisible to every method except Restore*, List* and Count*
+// with ListOptions.Deleted, and PurgeDeleted.
+
+// The editable fields of each record by Go field name. Passing them to an
+// Update method overwrites the whole record.
+var (
+	authorFields = []string{"AuthorName", "AuthorGender", "TypeofAuthor", "Affiliation", "AuthorEmail"}
+
+	ipAssetFields = []string{
+		"TitleOfWork", "TypeOfDocument", "ClassOfWork", "DateOfCreation", "DateRegistered", "Campus",
+		"College", "Program", "Authors", "Hyperlink", "Status", "Certificate", "DateOfCreationPrecision",
+		"DateRegisteredPrecision",
+	}
+
+	publicationFields = []string{
+		"DatePublished", "Quartile", "Authors", "Department", "College", "Campus", "TitleOfPaper",
+		"TypeOfPublication", "FundingSource", "NumberOfCitation", "GoogleScholarDetails", "SDGNo",
+		"FundingType", "NatureOfFunding", "Publisher", "Abstract", "Draft", "DatePublishedPrecision",
+	}
+)
+
+type AuthorStore interface {
+	CreateAuthor(ctx context.Context, author *Author) error
+	GetAuthor(ctx context.Context, id string) (*Author, error)
+	ListAuthors(ctx context.Context, opts ListOptions) ([]Author, error)
+	CountAuthors(ctx context.Context, opts ListOptions) (int64, error)
+	UpdateAuthor(ctx context.Context, author *Author, fields ...string) (*Author, error)
+	DeleteAuthor(ctx context.Context, id string, version int64) error
+	RestoreAuthor(ctx context.Context, id string) (*Author, error)
+}
+
+type IPAssetStore interface {
+	CreateIPAsset(ctx context.Context, ipAsset *IP_Asset) error
+	GetIPAsset(ctx context.Context, registrationNumber string) (*IP_Asset, error)
+	ListIPAssets(ctx context.Context, opts ListOptions) ([]IP_Asset, error)
+	CountIPAssets(ctx context.Context, opts ListOptions) (int64, error)
+	UpdateIPAsset(ctx context.Context, ipAsset *IP_Asset, fields ...string) (*IP_Asset, error)
+	DeleteIPAsset(ctx context.Context, registrationNumber string, version int64) error
+	RestoreIPAsset(ctx context.Context, registrationNumber string) (*IP_Asset, error)
+}
+
+type PublicationStore interface {
+	CreatePublication(ctx context.Context, publication *Publication) error
+	GetPublication(ctx context.Context, id string) (*Publication, error)
+	ListPublications(ctx context.Context, opts ListOptions) ([]Publication, error)
+	CountPublications(ctx context.Context, opts ListOptions) (int64, error)
+	UpdatePublication(ctx context.Context, publication *Publication, fields ...string) (*Publication, error)
+	DeletePublication(ctx context.Context, id string, version int64) error
+	RestorePublication(ctx context.Context, id string) (*Publication, error)
+}
+
+type UserStore interface {
+	CreateUser(ctx context.Context, user *User) error
+	GetUser(ctx context.Context, id int32) (*User, error)
+	// GetUserByLogin finds the user whose email or SR code is login.
+	GetUserByLogin(ctx context.Context, login string) (*User, error)
+	ListUsers(ctx context.Context, opts ListOptions) ([]User, error)
+	CountUsers(ctx context.Context, opts ListOptions) (int64, error)
+	UpdateUser(ctx context.Context, user *User, fields ...string) (*User, error)
+	// SetUserPassword replaces the stored password of a user without
+	// changing its version, so that upgrading a plaintext password to its
+	// hash does not fail the updates of clients that read the user before.
+	SetUserPassword(ctx context.Context, id int32, password string) error
+	DeleteUser(ctx context.Context, id int32, version int64) error
+}
+
+type LogStore interface {
+	CreateLog(ctx context.Context, log *Log) error
+	GetLog(ctx context.Context, id string) (*Log, error)
+	ListLogs(ctx context.Context, opts ListOptions) ([]Log, error)
+	CountLogs(ctx context.Context, opts ListOptions) (int64, error)
+}
+
+// SearchStore ranks publications and IP assets against a free-text query.
+type SearchStore interface {
+	Search(ctx context.Context, query string, limit int) ([]SearchHit, error)
+}
+
+// AuthorLinkStore keeps the ordered author lists of publications and IP
+// assets. Links to trashed authors and records are kept but not listed;
+// purging an author or a record removes its links.
+type AuthorLinkStore interface {
+	// LinkAuthor adds the author to the record, or replaces the order and role
+	// of an existing link. A zero AuthorOrder places the author last. It
+	// returns ErrNotFound when the record or the author does not exist.
+	LinkAuthor(ctx context.Context, kind recordKind, link AuthorLink) error
+	UnlinkAuthor(ctx context.Context, kind recordKind, recordID, authorID string) error
+	// LinkedAuthors returns the authors of each record in author order.
+	LinkedAuthors(ctx context.Context, kind recordKind, recordIDs []string) (map[string][]LinkedAuthor, error)
+	AuthorPublications(ctx context.Context, authorID string) ([]Publication, error)
+	AuthorIPAssets(ctx context.Context, authorID string) ([]IP_Asset, error)
+}
+
+// TrashStore permanently removes trashed authors, IP assets and publications.
+type TrashStore interface {
+	// PurgeDeleted hard-deletes the records trashed before the given time and
+	// returns how many it removed.
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+}
+
+// RevisionStore keeps the saved revisions of authors, IP assets and
+// publications. Revisions of a record are numbered from 1.
+type RevisionStore interface {
+	// CreateRevision saves rev as the next revision of its record and sets
+	// rev.Revision.
+	CreateRevision(ctx context.Context, rev *Revision) error
+	GetRevision(ctx context.Context, entity, entityID string, revision int32) (*Revision, error)
+	// ListRevisions returns the revisions of a record, oldest first.
+	ListRevisions(ctx context.Context, entity, entityID string) ([]Revision, error)
+}
+
+// TxStore runs several store calls in one transaction.
+type TxStore interface {
+	// Transaction calls fn with a context in which every call to the store
+	// is part of one transaction. The transaction is committed when fn
+	// returns nil and rolled back when it returns an error, which
+	// Transaction returns.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// VocabularyStore keeps the controlled vocabularies of categorical fields.
+type VocabularyStore interface {
+	// ListVocabulary returns the terms of a vocabulary, or of every vocabulary
+	// when it is "", ordered by vocabulary, position and value.
+	ListVocabulary(ctx context.Context, vocabulary string) ([]VocabularyTerm, error)
+	GetVocabularyTerm(ctx context.Context, vocabulary, value string) (*VocabularyTerm, error)
+	CreateVocabularyTerm(ctx context.Context, term *VocabularyTerm) error
+	UpdateVocabularyTerm(ctx context.Context, term *VocabularyTerm, fields ...string) (*VocabularyTerm, error)
+	DeleteVocabularyTerm(ctx context.Context, vocabulary, value string) error
+	// VocabularyViolations lists the live records whose value of a field is
+	// not a term of the field's vocabulary, for one vocabulary or for every
+	// one when it is "". Empty values are not checked, nor are vocabularies
+	// without terms unless they are strict.
+	VocabularyViolations(ctx context.Context, vocabulary string) ([]VocabularyViolation, error)
+}
@@ -0,0 +1,757 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// gormStore is the Postgres-backed Store used in production.
+type gormStore struct {
+	db *gorm.DB
+}
+
+func newGormStore(db *gorm.DB) *gormStore {
+	return &gormStore{db: db}
+}
+
+type txKey struct{}
+
+// logQueueKey holds the logQueue of the outermost transaction of a context.
+type logQueueKey struct{}
+
+// logQueue holds the log entries written within a transaction. They are
+// appended to the hash chain when the transaction is about to commit, so that
+// the lock serializing appends is held only from then until the commit rather
+// than from the first entry, however long the transaction runs.
+type logQueue struct {
+	logs []*Log
+}
+
+// conn returns the transaction ctx was given by Transaction, or the
+// database outside of one.
+func (s *gormStore) conn(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return s.db.WithContext(ctx)
+}
+
+// Transaction runs fn in a database transaction. Methods that open their own
+// transaction within it, and transactions within it, run in a savepoint.
+func (s *gormStore) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if q, ok := ctx.Value(logQueueKey{}).(*logQueue); ok {
+		n := len(q.logs)
+		err := s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+			return fn(context.WithValue(ctx, txKey{}, tx))
+		})
+		if err != nil {
+			q.logs = q.logs[:n]
+		}
+		return err
+	}
+	q := &logQueue{}
+	ctx = context.WithValue(ctx, logQueueKey{}, q)
+	return s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+			return err
+		}
+		return appendLogs(tx, q.logs)
+	})
+}
+
+// found converts the result of a single-row query or write into ErrNotFound
+// when no row matched.
+func found(res *gorm.DB) error {
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// created converts a unique violation on insert into ErrAlreadyExists.
+func created(res *gorm.DB) error {
+	var pgErr *pgconn.PgError
+	if errors.As(res.Error, &pgErr) && pgErr.Code == "23505" {
+		return ErrAlreadyExists
+	}
+	return res.Error
+}
+
+// filtered applies the filter of opts. gorm hides trashed rows of models with
+// a DeletedAt field; opts.Deleted selects only those rows instead.
+func filtered(db *gorm.DB, opts ListOptions) *gorm.DB {
+	if opts.Deleted {
+		db = db.Unscoped().Where("deleted_at IS NOT NULL")
+	}
+	if opts.Filter == nil {
+		return db
+	}
+	var b strings.Builder
+	var args []any
+	opts.Filter.sql(&b, &args)
+	return db.Where(b.String(), args...)
+}
+
+// page applies the filter, order, keyset position and limit of opts.
+func page(db *gorm.DB, opts ListOptions) *gorm.DB {
+	db = filtered(db, opts).Order(orderSQL(opts.OrderBy))
+	if opts.After != nil {
+		cond, args := keysetSQL(opts.OrderBy, opts.After)
+		db = db.Where(cond, args...)
+	}
+	if opts.Limit > 0 {
+		db = db.Limit(opts.Limit)
+	}
+	return db
+}
+
+// selected restricts an update to the named fields, zero values included.
+func selected(db *gorm.DB, fields []string) *gorm.DB {
+	if len(fields) == 0 {
+		return db
+	}
+	return db.Select(append([]string{"Version", "UpdatedAt"}, fields...))
+}
+
+// lockVersion locks the row db selects and returns its version, or
+// ErrStaleVersion when expected is non-zero and differs from it.
+func lockVersion(db *gorm.DB, expected int64) (int64, error) {
+	var versions []int64
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("version", &versions).Error; err != nil {
+		return 0, err
+	}
+	if len(versions) == 0 {
+		return 0, ErrNotFound
+	}
+	if expected != 0 && versions[0] != expected {
+		return 0, ErrStaleVersion
+	}
+	return versions[0], nil
+}
+
+// softDelete moves the row of model whose key column is id to the trash.
+func softDelete(db *gorm.DB, table string, model any, column, id string, version int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		current, err := lockVersion(tx.Table(table).Model(model).Where(column+" = ?", id), version)
+		if err != nil {
+			return err
+		}
+		return tx.Table(table).Model(model).Where(column+" = ?", id).
+			Updates(map[string]any{"deleted_at": time.Now(), "version": current + 1}).Error
+	})
+}
+
+// Author
+func (s *gormStore) CreateAuthor(ctx context.Context, author *Author) error {
+	return created(s.conn(ctx).Table("table_authors").Create(author))
+}
+
+func (s *gormStore) GetAuthor(ctx context.Context, id string) (*Author, error) {
+	var author Author
+	res := s.conn(ctx).Table("table_authors").Find(&author, "author_id = ?", id)
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return &author, nil
+}
+
+func (s *gormStore) ListAuthors(ctx context.Context, opts ListOptions) ([]Author, error) {
+	var authors []Author
+	res := page(s.conn(ctx).Table("table_authors"), opts).Find(&authors)
+	return authors, res.Error
+}
+
+func (s *gormStore) CountAuthors(ctx context.Context, opts ListOptions) (int64, error) {
+	var n int64
+	err := filtered(s.conn(ctx).Table("table_authors").Model(&Author{}), opts).Count(&n).Error
+	return n, err
+}
+
+func (s *gormStore) UpdateAuthor(ctx context.Context, author *Author, fields ...string) (*Author, error) {
+	err := s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		version, err := lockVersion(tx.Table("table_authors").Model(&Author{}).Where("author_id = ?", author.ID), author.Version)
+		if err != nil {
+			return err
+		}
+		return selected(tx.Table("table_authors").Model(&Author{}), fields).Where("author_id = ?", author.ID).Updates(
+			Author{
+				AuthorName:   author.AuthorName,
+				AuthorGender: author.AuthorGender,
+				TypeofAuthor: author.TypeofAuthor,
+				Affiliation:  author.Affiliation,
+				AuthorEmail:  author.AuthorEmail,
+				Version:      version + 1,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetAuthor(ctx, author.ID)
+}
+
+func (s *gormStore) DeleteAuthor(ctx context.Context, id string, version int64) error {
+	return softDelete(s.conn(ctx), "table_authors", &Author{}, "author_id", id, version)
+}
+
+func (s *gormStore) RestoreAuthor(ctx context.Context, id string) (*Author, error) {
+	res := s.conn(ctx).Unscoped().Table("table_authors").Model(&Author{}).
+		Where("author_id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{"deleted_at": nil, "version": gorm.Expr("version + 1")})
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return s.GetAuthor(ctx, id)
+}
+
+// IP_Asset
+func (s *gormStore) CreateIPAsset(ctx context.Context, ipAsset *IP_Asset) error {
+	return created(s.conn(ctx).Table("table_ipassets").Create(ipAsset))
+}
+
+func (s *gormStore) GetIPAsset(ctx context.Context, registrationNumber string) (*IP_Asset, error) {
+	var ipAsset IP_Asset
+	res := s.conn(ctx).Table("table_ipassets").Find(&ipAsset, "registration_number = ?", registrationNumber)
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return &ipAsset, nil
+}
+
+func (s *gormStore) ListIPAssets(ctx context.Context, opts ListOptions) ([]IP_Asset, error) {
+	var ipAssets []IP_Asset
+	res := page(s.conn(ctx).Table("table_ipassets"), opts).Find(&ipAssets)
+	return ipAssets, res.Error
+}
+
+func (s *gormStore) CountIPAssets(ctx context.Context, opts ListOptions) (int64, error) {
+	var n int64
+	err := filtered(s.conn(ctx).Table("table_ipassets").Model(&IP_Asset{}), opts).Count(&n).Error
+	return n, err
+}
+
+func (s *gormStore) UpdateIPAsset(ctx context.Context, ipAsset *IP_Asset, fields ...string) (*IP_Asset, error) {
+	err := s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		version, err := lockVersion(tx.Table("table_ipassets").Model(&IP_Asset{}).Where("registration_number = ?", ipAsset.RegistrationNumber), ipAsset.Version)
+		if err != nil {
+			return err
+		}
+		return selected(tx.Table("table_ipassets").Model(&IP_Asset{}), fields).Where("registration_number = ?", ipAsset.RegistrationNumber).Updates(
+			IP_Asset{
+				TitleOfWork:             ipAsset.TitleOfWork,
+				TypeOfDocument:          ipAsset.TypeOfDocument,
+				ClassOfWork:             ipAsset.ClassOfWork,
+				DateOfCreation:          ipAsset.DateOfCreation,
+				DateRegistered:          ipAsset.DateRegistered,
+				Campus:                  ipAsset.Campus,
+				College:                 ipAsset.College,
+				Program:                 ipAsset.Program,
+				Authors:                 ipAsset.Authors,
+				Hyperlink:               ipAsset.Hyperlink,
+				Status:                  ipAsset.Status,
+				Certificate:             ipAsset.Certificate,
+				DateOfCreationPrecision: ipAsset.DateOfCreationPrecision,
+				DateRegisteredPrecision: ipAsset.DateRegisteredPrecision,
+				Version:                 version + 1,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetIPAsset(ctx, ipAsset.RegistrationNumber)
+}
+
+func (s *gormStore) DeleteIPAsset(ctx context.Context, registrationNumber string, version int64) error {
+	return softDelete(s.conn(ctx), "table_ipassets", &IP_Asset{}, "registration_number", registrationNumber, version)
+}
+
+func (s *gormStore) RestoreIPAsset(ctx context.Context, registrationNumber string) (*IP_Asset, error) {
+	res := s.conn(ctx).Unscoped().Table("table_ipassets").Model(&IP_Asset{}).
+		Where("registration_number = ? AND deleted_at IS NOT NULL", registrationNumber).
+		Updates(map[string]any{"deleted_at": nil, "version": gorm.Expr("version + 1")})
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return s.GetIPAsset(ctx, registrationNumber)
+}
+
+// Publication
+func (s *gormStore) CreatePublication(ctx context.Context, publication *Publication) error {
+	return created(s.conn(ctx).Table("table_publications").Create(publication))
+}
+
+func (s *gormStore) GetPublication(ctx context.Context, id string) (*Publication, error) {
+	var publication Publication
+	res := s.conn(ctx).Table("table_publications").Find(&publication, "publication_id = ?", id)
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return &publication, nil
+}
+
+func (s *gormStore) ListPublications(ctx context.Context, opts ListOptions) ([]Publication, error) {
+	var publications []Publication
+	res := page(s.conn(ctx).Table("table_publications"), opts).Find(&publications)
+	return publications, res.Error
+}
+
+func (s *gormStore) CountPublications(ctx context.Context, opts ListOptions) (int64, error) {
+	var n int64
+	err := filtered(s.conn(ctx).Table("table_publications").Model(&Publication{}), opts).Count(&n).Error
+	return n, err
+}
+
+func (s *gormStore) UpdatePublication(ctx context.Context, publication *Publication, fields ...string) (*Publication, error) {
+	err := s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		version, err := lockVersion(tx.Table("table_publications").Model(&Publication{}).Where("publication_id = ?", publication.PublicationID), publication.Version)
+		if err != nil {
+			return err
+		}
+		return selected(tx.Table("table_publications").Model(&Publication{}), fields).Where("publication_id = ?", publication.PublicationID).Updates(
+			Publication{
+				DatePublished:          publication.DatePublished,
+				Quartile:               publication.Quartile,
+				Authors:                publication.Authors,
+				Department:             publication.Department,
+				College:                publication.College,
+				Campus:                 publication.Campus,
+				TitleOfPaper:           publication.TitleOfPaper,
+				TypeOfPublication:      publication.TypeOfPublication,
+				FundingSource:          publication.FundingSource,
+				NumberOfCitation:       publication.NumberOfCitation,
+				GoogleScholarDetails:   publication.GoogleScholarDetails,
+				SDGNo:                  publication.SDGNo,
+				FundingType:            publication.FundingType,
+				NatureOfFunding:        publication.NatureOfFunding,
+				Publisher:              publication.Publisher,
+				Abstract:               publication.Abstract,
+				Draft:                  publication.Draft,
+				DatePublishedPrecision: publication.DatePublishedPrecision,
+				Version:                version + 1,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPublication(ctx, publication.PublicationID)
+}
+
+func (s *gormStore) DeletePublication(ctx context.Context, id string, version int64) error {
+	return softDelete(s.conn(ctx), "table_publications", &Publication{}, "publication_id", id, version)
+}
+
+func (s *gormStore) RestorePublication(ctx context.Context, id string) (*Publication, error) {
+	res := s.conn(ctx).Unscoped().Table("table_publications").Model(&Publication{}).
+		Where("publication_id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{"deleted_at": nil, "version": gorm.Expr("version + 1")})
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return s.GetPublication(ctx, id)
+}
+
+// User
+func (s *gormStore) CreateUser(ctx context.Context, user *User) error {
+	return created(s.conn(ctx).Table("table_user").Create(user))
+}
+
+func (s *gormStore) GetUser(ctx context.Context, id int32) (*User, error) {
+	var user User
+	res := s.conn(ctx).Table("table_user").Find(&user, "user_id = ?", id)
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (s *gormStore) GetUserByLogin(ctx context.Context, login string) (*User, error) {
+	var user User
+	res := s.conn(ctx).Table("table_user").
+		Where("email = ? OR sr_code = ?", login, login).
+		Order("user_id").Limit(1).Find(&user)
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (s *gormStore) ListUsers(ctx context.Context, opts ListOptions) ([]User, error) {
+	var users []User
+	res := page(s.conn(ctx).Table("table_user"), opts).Find(&users)
+	return users, res.Error
+}
+
+func (s *gormStore) CountUsers(ctx context.Context, opts ListOptions) (int64, error) {
+	var n int64
+	err := filtered(s.conn(ctx).Table("table_user"), opts).Count(&n).Error
+	return n, err
+}
+
+func (s *gormStore) UpdateUser(ctx context.Context, user *User, fields ...string) (*User, error) {
+	err := s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		version, err := lockVersion(tx.Table("table_user").Model(&User{}).Where("user_id = ?", user.UserID), user.Version)
+		if err != nil {
+			return err
+		}
+		return selected(tx.Table("table_user").Model(&User{}), fields).Where("user_id = ?", user.UserID).Updates(
+			User{
+				SRCode:      user.SRCode,
+				Email:       user.Email,
+				Password:    user.Password,
+				AccountType: user.AccountType,
+				UserContact: user.UserContact,
+				UserImg:     user.UserImg,
+				UserFname:   user.UserFname,
+				UserLname:   user.UserLname,
+				UserMname:   user.UserMname,
+				Version:     version + 1,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetUser(ctx, user.UserID)
+}
+
+func (s *gormStore) SetUserPassword(ctx context.Context, id int32, password string) error {
+	return found(s.conn(ctx).Table("table_user").Where("user_id = ?", id).UpdateColumn("password", password))
+}
+
+func (s *gormStore) DeleteUser(ctx context.Context, id int32, version int64) error {
+	return s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockVersion(tx.Table("table_user").Model(&User{}).Where("user_id = ?", id), version); err != nil {
+			return err
+		}
+		return tx.Table("table_user").Where("user_id = ?", id).Delete(&User{}).Error
+	})
+}
+
+// Log
+// logChainLockID is the advisory lock key that serializes appends to the log
+// hash chain.
+const logChainLockID = 7283402
+
+// CreateLog appends log to the hash chain. Within a transaction the entry is
+// queued and appended when the outermost transaction commits, so its Seq, Hash
+// and PrevHash stay unset until then: callers in the transaction see them as
+// zero.
+func (s *gormStore) CreateLog(ctx context.Context, log *Log) error {
+	if q, ok := ctx.Value(logQueueKey{}).(*logQueue); ok {
+		q.logs = append(q.logs, log)
+		return nil
+	}
+	return s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		return appendLogs(tx, []*Log{log})
+	})
+}
+
+// appendLogs appends logs to the hash chain in order, holding the chain's
+// lock until tx ends.
+func appendLogs(tx *gorm.DB, logs []*Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", logChainLockID).Error; err != nil {
+		return err
+	}
+	var head Log
+	if err := tx.Table("table_log").Order("seq DESC").Limit(1).Find(&head).Error; err != nil {
+		return err
+	}
+	for _, log := range logs {
+		chainLog(log, &head)
+		if err := tx.Table("table_log").Create(log).Error; err != nil {
+			return err
+		}
+		head = *log
+	}
+	return nil
+}
+
+func (s *gormStore) GetLog(ctx context.Context, id string) (*Log, error) {
+	var log Log
+	res := s.conn(ctx).Table("table_log").Find(&log, "log_id = ?", id)
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return &log, nil
+}
+
+func (s *gormStore) ListLogs(ctx context.Context, opts ListOptions) ([]Log, error) {
+	var logs []Log
+	res := page(s.conn(ctx).Table("table_log"), opts).Find(&logs)
+	return logs, res.Error
+}
+
+func (s *gormStore) CountLogs(ctx context.Context, opts ListOptions) (int64, error) {
+	var n int64
+	err := filtered(s.conn(ctx).Table("table_log"), opts).Count(&n).Error
+	return n, err
+}
+
+// Author links
+
+// authorLinkTable describes the join table linking authors to one kind of
+// record.
+type authorLinkTable struct {
+	table   string
+	records string
+	column  string
+}
+
+var authorLinkTables = map[recordKind]authorLinkTable{
+	kindPublication: {"table_publication_authors", "table_publications", "publication_id"},
+	kindIPAsset:     {"table_ipasset_authors", "table_ipassets", "registration_number"},
+}
+
+func (s *gormStore) LinkAuthor(ctx context.Context, kind recordKind, link AuthorLink) error {
+	t := authorLinkTables[kind]
+	return s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var n int64
+		if err := tx.Table(t.records).Where(t.column+" = ? AND deleted_at IS NULL", link.RecordID).Count(&n).Error; err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrNotFound
+		}
+		if err := tx.Table("table_authors").Where("author_id = ? AND deleted_at IS NULL", link.AuthorID).Count(&n).Error; err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrNotFound
+		}
+		if link.AuthorOrder == 0 {
+			err := tx.Table(t.table).
+				Where(t.column+" = ? AND author_id <> ?", link.RecordID, link.AuthorID).
+				Select("COALESCE(MAX(author_order), 0) + 1").
+				Scan(&link.AuthorOrder).Error
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Exec("INSERT INTO "+t.table+" ("+t.column+", author_id, author_order, role) VALUES (?, ?, ?, ?) "+
+			"ON CONFLICT ("+t.column+", author_id) DO UPDATE SET author_order = EXCLUDED.author_order, role = EXCLUDED.role",
+			link.RecordID, link.AuthorID, link.AuthorOrder, link.Role).Error
+	})
+}
+
+func (s *gormStore) UnlinkAuthor(ctx context.Context, kind recordKind, recordID, authorID string) error {
+	t := authorLinkTables[kind]
+	return found(s.conn(ctx).Exec("DELETE FROM "+t.table+" WHERE "+t.column+" = ? AND author_id = ?", recordID, authorID))
+}
+
+func (s *gormStore) LinkedAuthors(ctx context.Context, kind recordKind, recordIDs []string) (map[string][]LinkedAuthor, error) {
+	linked := map[string][]LinkedAuthor{}
+	if len(recordIDs) == 0 {
+		return linked, nil
+	}
+	t := authorLinkTables[kind]
+	var rows []LinkedAuthor
+	err := s.conn(ctx).Unscoped().Table(t.table+" AS l").
+		Select("a.*, l."+t.column+" AS record_id, l.author_order, l.role").
+		Joins("JOIN table_authors a ON a.author_id = l.author_id AND a.deleted_at IS NULL").
+		Where("l."+t.column+" IN ?", recordIDs).
+		Order("l.author_order, a.author_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		linked[row.RecordID] = append(linked[row.RecordID], row)
+	}
+	return linked, nil
+}
+
+func (s *gormStore) AuthorPublications(ctx context.Context, authorID string) ([]Publication, error) {
+	var publications []Publication
+	err := s.conn(ctx).Unscoped().Table("table_publications AS p").
+		Select("p.*").
+		Joins("JOIN table_publication_authors l ON l.publication_id = p.publication_id").
+		Where("l.author_id = ? AND p.deleted_at IS NULL", authorID).
+		Order("p.date_published DESC NULLS LAST, p.publication_id").
+		Find(&publications).Error
+	return publications, err
+}
+
+func (s *gormStore) AuthorIPAssets(ctx context.Context, authorID string) ([]IP_Asset, error) {
+	var ipAssets []IP_Asset
+	err := s.conn(ctx).Unscoped().Table("table_ipassets AS i").
+		Select("i.*").
+		Joins("JOIN table_ipasset_authors l ON l.registration_number = i.registration_number").
+		Where("l.author_id = ? AND i.deleted_at IS NULL", authorID).
+		Order("i.date_registered DESC NULLS LAST, i.registration_number").
+		Find(&ipAssets).Error
+	return ipAssets, err
+}
+
+// Trash
+func (s *gormStore) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	var n int64
+	err := s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		purges := []struct {
+			table  string
+			column string
+			entity string
+			model  any
+		}{
+			{"table_publications", "publication_id", entityPublication, &Publication{}},
+			{"table_ipassets", "registration_number", entityIPAsset, &IP_Asset{}},
+			{"table_authors", "author_id", entityAuthor, &Author{}},
+		}
+		for _, p := range purges {
+			expired := tx.Unscoped().Table(p.table).Select(p.column).Where("deleted_at < ?", before)
+			if err := tx.Table("table_revisions").Where("entity = ? AND entity_id IN (?)", p.entity, expired).Delete(&Revision{}).Error; err != nil {
+				return err
+			}
+			res := tx.Unscoped().Table(p.table).Where("deleted_at < ?", before).Delete(p.model)
+			if res.Error != nil {
+				return res.Error
+			}
+			n += res.RowsAffected
+		}
+		return nil
+	})
+	return n, err
+}
+
+// Revisions
+// revisionLockID is the advisory lock key that, together with a hash of the
+// record, serializes numbering the revisions of one record.
+const revisionLockID = 7283403
+
+func (s *gormStore) CreateRevision(ctx context.Context, rev *Revision) error {
+	return s.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?, hashtext(?))", revisionLockID, rev.Entity+"/"+rev.EntityID).Error; err != nil {
+			return err
+		}
+		err := tx.Table("table_revisions").
+			Where("entity = ? AND entity_id = ?", rev.Entity, rev.EntityID).
+			Select("COALESCE(MAX(revision), 0) + 1").
+			Scan(&rev.Revision).Error
+		if err != nil {
+			return err
+		}
+		return tx.Table("table_revisions").Create(rev).Error
+	})
+}
+
+func (s *gormStore) GetRevision(ctx context.Context, entity, entityID string, revision int32) (*Revision, error) {
+	var rev Revision
+	res := s.conn(ctx).Table("table_revisions").
+		Find(&rev, "entity = ? AND entity_id = ? AND revision = ?", entity, entityID, revision)
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+func (s *gormStore) ListRevisions(ctx context.Context, entity, entityID string) ([]Revision, error) {
+	var revs []Revision
+	err := s.conn(ctx).Table("table_revisions").
+		Where("entity = ? AND entity_id = ?", entity, entityID).
+		Order("revision").
+		Find(&revs).Error
+	return revs, err
+}
+
+// Vocabularies
+func (s *gormStore) ListVocabulary(ctx context.Context, vocabulary string) ([]VocabularyTerm, error) {
+	var terms []VocabularyTerm
+	db := s.conn(ctx).Table("table_vocabulary")
+	if vocabulary != "" {
+		db = db.Where("vocabulary = ?", vocabulary)
+	}
+	err := db.Order("vocabulary, position, value").Find(&terms).Error
+	return terms, err
+}
+
+func (s *gormStore) GetVocabularyTerm(ctx context.Context, vocabulary, value string) (*VocabularyTerm, error) {
+	var term VocabularyTerm
+	res := s.conn(ctx).Table("table_vocabulary").Find(&term, "vocabulary = ? AND value = ?", vocabulary, value)
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return &term, nil
+}
+
+func (s *gormStore) CreateVocabularyTerm(ctx context.Context, term *VocabularyTerm) error {
+	return created(s.conn(ctx).Table("table_vocabulary").Create(term))
+}
+
+func (s *gormStore) UpdateVocabularyTerm(ctx context.Context, term *VocabularyTerm, fields ...string) (*VocabularyTerm, error) {
+	db := s.conn(ctx).Table("table_vocabulary").Model(&VocabularyTerm{})
+	if len(fields) > 0 {
+		db = db.Select(append([]string{"UpdatedAt"}, fields...))
+	}
+	res := db.Where("vocabulary = ? AND value = ?", term.Vocabulary, term.Value).Updates(
+		VocabularyTerm{
+			Label:    term.Label,
+			Position: term.Position,
+		})
+	if err := found(res); err != nil {
+		return nil, err
+	}
+	return s.GetVocabularyTerm(ctx, term.Vocabulary, term.Value)
+}
+
+func (s *gormStore) DeleteVocabularyTerm(ctx context.Context, vocabulary, value string) error {
+	return found(s.conn(ctx).Table("table_vocabulary").
+		Where("vocabulary = ? AND value = ?", vocabulary, value).Delete(&VocabularyTerm{}))
+}
+
+func (s *gormStore) VocabularyViolations(ctx context.Context, vocabulary string) ([]VocabularyViolation, error) {
+	violations := []VocabularyViolation{}
+	for _, f := range checkedVocabularyFields(vocabulary) {
+		var rows []VocabularyViolation
+		q := s.conn(ctx).Table(f.table+" AS r").
+			Select("? AS vocabulary, ? AS entity, r."+f.key+" AS entity_id, r."+f.name+" AS value", f.name, f.entity).
+			Where("r.deleted_at IS NULL AND r." + f.name + " <> ''")
+		if !strictVocabularies[f.name] {
+			q = q.Where("EXISTS (SELECT 1 FROM table_vocabulary t WHERE t.vocabulary = ?)", f.name)
+		}
+		err := q.Where("NOT EXISTS (SELECT 1 FROM table_vocabulary t WHERE t.vocabulary = ? AND t.value = r."+f.name+")", f.name).
+			Order("r." + f.key).
+			Scan(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, rows...)
+	}
+	return violations, nil
+}
+
+// Search
+const searchSQL = `
+WITH q AS (SELECT websearch_to_tsquery('english', @query) AS query)
+SELECT 'publication' AS kind, publication_id AS id, title_of_paper AS title,
+	ts_headline('english', concat_ws(' … ', title_of_paper, authors, publisher, abstract), q.query, @options) AS snippet,
+	ts_rank(search_vector, q.query) AS rank
+FROM table_publications, q
+WHERE search_vector @@ q.query AND deleted_at IS NULL
+UNION ALL
+SELECT 'ip_asset', registration_number, title_of_work,
+	ts_headline('english', title_of_work, q.query, @options),
+	ts_rank(search_vector, q.query)
+FROM table_ipassets, q
+WHERE search_vector @@ q.query AND deleted_at IS NULL
+ORDER BY rank DESC, id
+LIMIT @limit`
+
+func (s *gormStore) Search(ctx context.Context, query string, limit int) ([]SearchHit, error) {
+	var hits []SearchHit
+	err := s.conn(ctx).Raw(searchSQL,
+		sql.Named("query", query),
+		sql.Named("options", "StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=30, MinWords=10"),
+		sql.Named("limit", limit),
+	).Scan(&hits).Error
+	return hits, err
+}
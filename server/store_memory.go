@@ -0,0 +1,905 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// memoryStore keeps every table in process memory. It is used to run the
+// server without Postgres, e.g. for local development and tests.
+type memoryStore struct {
+	mu           sync.RWMutex
+	authors      map[string]Author
+	ipAssets     map[string]IP_Asset
+	publications map[string]Publication
+	users        map[int32]User
+	logs         map[string]Log
+	authorLinks  map[authorLinkKey]AuthorLink
+	lastUserID   int32
+	logHead      Log
+
+	// Trashed rows are moved out of the live tables until they are restored
+	// or purged.
+	deletedAuthors      map[string]Author
+	deletedIPAssets     map[string]IP_Asset
+	deletedPublications map[string]Publication
+
+	revisions map[revisionKey][]Revision
+
+	vocabulary map[vocabularyKey]VocabularyTerm
+}
+
+type vocabularyKey struct {
+	vocabulary string
+	value      string
+}
+
+type revisionKey struct {
+	entity   string
+	entityID string
+}
+
+type authorLinkKey struct {
+	kind     recordKind
+	recordID string
+	authorID string
+}
+
+func newMemoryStore() *memoryStore {
+	s := &memoryStore{
+		authors:      map[string]Author{},
+		ipAssets:     map[string]IP_Asset{},
+		publications: map[string]Publication{},
+		users:        map[int32]User{},
+		logs:         map[string]Log{},
+		authorLinks:  map[authorLinkKey]AuthorLink{},
+
+		deletedAuthors:      map[string]Author{},
+		deletedIPAssets:     map[string]IP_Asset{},
+		deletedPublications: map[string]Publication{},
+
+		revisions: map[revisionKey][]Revision{},
+
+		vocabulary: map[vocabularyKey]VocabularyTerm{},
+	}
+	for _, term := range seedVocabularyTerms {
+		s.vocabulary[vocabularyKey{term.Vocabulary, term.Value}] = term
+	}
+	return s
+}
+
+// memoryTxKey holds the memoryTx a context belongs to.
+type memoryTxKey struct{}
+
+// memoryTx is a transaction, or a savepoint within one, of a memory store.
+// saved restores each table written since it began to its state at that
+// point.
+type memoryTx struct {
+	store *memoryStore
+	outer *memoryTx
+	saved map[any]func()
+}
+
+// memoryTable is a table, or another part of the state of a store, that a
+// failed transaction restores.
+type memoryTable struct {
+	id any
+	// save copies the table and returns a function that restores the copy.
+	save func() func()
+}
+
+func table[K comparable, V any](m *map[K]V) memoryTable {
+	return memoryTable{m, func() func() {
+		saved := cloneMap(*m)
+		return func() { *m = saved }
+	}}
+}
+
+func field[T any](p *T) memoryTable {
+	return memoryTable{p, func() func() {
+		saved := *p
+		return func() { *p = saved }
+	}}
+}
+
+// save copies each table the first time tx or a transaction it is within
+// writes to it.
+func (tx *memoryTx) save(tables []memoryTable) {
+	for _, t := range tables {
+		for tx := tx; tx != nil; tx = tx.outer {
+			if _, ok := tx.saved[t.id]; ok {
+				// The transactions it is within saved it first.
+				break
+			}
+			tx.saved[t.id] = t.save()
+		}
+	}
+}
+
+// tx returns the transaction of s that ctx belongs to, if any.
+func (s *memoryStore) tx(ctx context.Context) *memoryTx {
+	if tx, ok := ctx.Value(memoryTxKey{}).(*memoryTx); ok && tx.store == s {
+		return tx
+	}
+	return nil
+}
+
+// lock write-locks the store for one call that writes to tables and returns
+// the matching unlock. When ctx belongs to a transaction, which already holds
+// the lock, it saves the tables for a rollback instead.
+func (s *memoryStore) lock(ctx context.Context, tables ...memoryTable) func() {
+	if tx := s.tx(ctx); tx != nil {
+		tx.save(tables)
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// rlock is lock for calls that only read.
+func (s *memoryStore) rlock(ctx context.Context) func() {
+	if s.tx(ctx) != nil {
+		return func() {}
+	}
+	s.mu.RLock()
+	return s.mu.RUnlock
+}
+
+// Transaction holds the store's lock while fn runs, so that no other call
+// sees or interleaves with its writes, and when fn fails restores the tables
+// fn wrote to to their state before fn. Only those tables are copied, on
+// their first write. A transaction within another restores only its own
+// writes, like a savepoint.
+func (s *memoryStore) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	defer s.lock(ctx)()
+	tx := &memoryTx{store: s, outer: s.tx(ctx), saved: map[any]func(){}}
+	if err := fn(context.WithValue(ctx, memoryTxKey{}, tx)); err != nil {
+		for _, restore := range tx.saved {
+			restore()
+		}
+		return err
+	}
+	return nil
+}
+
+// cloneMap copies a table. Revision lists are shared with the copy, which is
+// safe because they are only ever appended to.
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	c := make(map[K]V, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+type rowKey interface {
+	~string | ~int32
+}
+
+func insertRow[K rowKey, V any](rows map[K]V, key K, row V) error {
+	if _, ok := rows[key]; ok {
+		return ErrAlreadyExists
+	}
+	rows[key] = row
+	return nil
+}
+
+// insertLiveRow is insertRow for a table with a trash: like a primary key,
+// the key of a trashed row stays taken until the row is purged.
+func insertLiveRow[K rowKey, V any](rows, trash map[K]V, key K, row V) error {
+	if _, ok := trash[key]; ok {
+		return ErrAlreadyExists
+	}
+	return insertRow(rows, key, row)
+}
+
+func getRow[K rowKey, V any](rows map[K]V, key K) (*V, error) {
+	row, ok := rows[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &row, nil
+}
+
+// listRows returns the rows selected by opts in opts.OrderBy order.
+func listRows[K rowKey, V any](rows map[K]V, opts ListOptions) []V {
+	list := []V{}
+	for _, row := range rows {
+		v := reflect.ValueOf(row)
+		if opts.Filter != nil && !opts.Filter.match(v) {
+			continue
+		}
+		if opts.After != nil && !afterKey(opts.OrderBy, v, opts.After) {
+			continue
+		}
+		list = append(list, row)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return compareRows(opts.OrderBy, reflect.ValueOf(list[i]), reflect.ValueOf(list[j])) < 0
+	})
+	if opts.Limit > 0 && len(list) > opts.Limit {
+		list = list[:opts.Limit]
+	}
+	return list
+}
+
+func countRows[K rowKey, V any](rows map[K]V, opts ListOptions) int64 {
+	if opts.Filter == nil {
+		return int64(len(rows))
+	}
+	var n int64
+	for _, row := range rows {
+		if opts.Filter.match(reflect.ValueOf(row)) {
+			n++
+		}
+	}
+	return n
+}
+
+func updateRow[K rowKey, V any](rows map[K]V, key K, patch *V, fields []string) (*V, error) {
+	row, ok := rows[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if err := bumpVersion(&row, versionOf(patch).Int()); err != nil {
+		return nil, err
+	}
+	versionOf(patch).Set(versionOf(&row))
+	if len(fields) > 0 {
+		mergeFields(&row, patch, append([]string{"Version", "UpdatedAt"}, fields...))
+	} else {
+		mergeNonZero(&row, patch)
+	}
+	rows[key] = row
+	return &row, nil
+}
+
+func deleteRow[K rowKey, V any](rows map[K]V, key K, version int64) error {
+	row, ok := rows[key]
+	if !ok {
+		return ErrNotFound
+	}
+	if err := bumpVersion(&row, version); err != nil {
+		return err
+	}
+	delete(rows, key)
+	return nil
+}
+
+// versionOf returns the settable Version field of a pointer to a row.
+func versionOf(row any) reflect.Value {
+	return reflect.ValueOf(row).Elem().FieldByName("Version")
+}
+
+// bumpVersion increments the version of a row, or returns ErrStaleVersion
+// when expected is non-zero and differs from it.
+func bumpVersion(row any, expected int64) error {
+	v := versionOf(row)
+	if expected != 0 && v.Int() != expected {
+		return ErrStaleVersion
+	}
+	v.SetInt(v.Int() + 1)
+	return nil
+}
+
+// mergeFields copies the named fields of src into dst, zero values included.
+func mergeFields(dst, src any, fields []string) {
+	d := reflect.ValueOf(dst).Elem()
+	s := reflect.ValueOf(src).Elem()
+	for _, name := range fields {
+		d.FieldByName(name).Set(s.FieldByName(name))
+	}
+}
+
+// trashRow moves a row into the trash and sets its DeletedAt.
+func trashRow[K rowKey, V any](rows, trash map[K]V, key K, version int64) error {
+	row, ok := rows[key]
+	if !ok {
+		return ErrNotFound
+	}
+	if err := bumpVersion(&row, version); err != nil {
+		return err
+	}
+	deletedAt := gorm.DeletedAt{Time: time.Now(), Valid: true}
+	reflect.ValueOf(&row).Elem().FieldByName("DeletedAt").Set(reflect.ValueOf(deletedAt))
+	delete(rows, key)
+	trash[key] = row
+	return nil
+}
+
+// restoreRow moves a row out of the trash and clears its DeletedAt.
+func restoreRow[K rowKey, V any](rows, trash map[K]V, key K) (*V, error) {
+	row, ok := trash[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if err := bumpVersion(&row, 0); err != nil {
+		return nil, err
+	}
+	reflect.ValueOf(&row).Elem().FieldByName("DeletedAt").Set(reflect.ValueOf(gorm.DeletedAt{}))
+	if err := insertRow(rows, key, row); err != nil {
+		return nil, err
+	}
+	delete(trash, key)
+	return &row, nil
+}
+
+// purgeRows removes the rows trashed before the given time and returns their
+// keys.
+func purgeRows[K rowKey, V any](trash map[K]V, before time.Time) []K {
+	var purged []K
+	for key, row := range trash {
+		deletedAt := reflect.ValueOf(row).FieldByName("DeletedAt").Interface().(gorm.DeletedAt)
+		if deletedAt.Time.Before(before) {
+			delete(trash, key)
+			purged = append(purged, key)
+		}
+	}
+	return purged
+}
+
+// mergeNonZero copies the non-zero fields of src into dst, mirroring how
+// gorm's Updates skips zero values.
+func mergeNonZero(dst, src any) {
+	d := reflect.ValueOf(dst).Elem()
+	s := reflect.ValueOf(src).Elem()
+	for i := 0; i < s.NumField(); i++ {
+		if f := s.Field(i); !f.IsZero() {
+			d.Field(i).Set(f)
+		}
+	}
+}
+
+// Author
+func (s *memoryStore) CreateAuthor(ctx context.Context, author *Author) error {
+	defer s.lock(ctx, table(&s.authors))()
+	author.Version = 1
+	author.CreatedAt = time.Now()
+	author.UpdatedAt = author.CreatedAt
+	return insertLiveRow(s.authors, s.deletedAuthors, author.ID, *author)
+}
+
+func (s *memoryStore) GetAuthor(ctx context.Context, id string) (*Author, error) {
+	defer s.rlock(ctx)()
+	return getRow(s.authors, id)
+}
+
+func (s *memoryStore) ListAuthors(ctx context.Context, opts ListOptions) ([]Author, error) {
+	defer s.rlock(ctx)()
+	if opts.Deleted {
+		return listRows(s.deletedAuthors, opts), nil
+	}
+	return listRows(s.authors, opts), nil
+}
+
+func (s *memoryStore) CountAuthors(ctx context.Context, opts ListOptions) (int64, error) {
+	defer s.rlock(ctx)()
+	if opts.Deleted {
+		return countRows(s.deletedAuthors, opts), nil
+	}
+	return countRows(s.authors, opts), nil
+}
+
+func (s *memoryStore) UpdateAuthor(ctx context.Context, author *Author, fields ...string) (*Author, error) {
+	defer s.lock(ctx, table(&s.authors))()
+	patch := *author
+	patch.UpdatedAt = time.Now()
+	return updateRow(s.authors, author.ID, &patch, fields)
+}
+
+func (s *memoryStore) DeleteAuthor(ctx context.Context, id string, version int64) error {
+	defer s.lock(ctx, table(&s.authors), table(&s.deletedAuthors))()
+	return trashRow(s.authors, s.deletedAuthors, id, version)
+}
+
+func (s *memoryStore) RestoreAuthor(ctx context.Context, id string) (*Author, error) {
+	defer s.lock(ctx, table(&s.authors), table(&s.deletedAuthors))()
+	return restoreRow(s.authors, s.deletedAuthors, id)
+}
+
+// IP_Asset
+func (s *memoryStore) CreateIPAsset(ctx context.Context, ipAsset *IP_Asset) error {
+	defer s.lock(ctx, table(&s.ipAssets))()
+	ipAsset.Version = 1
+	ipAsset.CreatedAt = time.Now()
+	ipAsset.UpdatedAt = ipAsset.CreatedAt
+	return insertLiveRow(s.ipAssets, s.deletedIPAssets, ipAsset.RegistrationNumber, *ipAsset)
+}
+
+func (s *memoryStore) GetIPAsset(ctx context.Context, registrationNumber string) (*IP_Asset, error) {
+	defer s.rlock(ctx)()
+	return getRow(s.ipAssets, registrationNumber)
+}
+
+func (s *memoryStore) ListIPAssets(ctx context.Context, opts ListOptions) ([]IP_Asset, error) {
+	defer s.rlock(ctx)()
+	if opts.Deleted {
+		return listRows(s.deletedIPAssets, opts), nil
+	}
+	return listRows(s.ipAssets, opts), nil
+}
+
+func (s *memoryStore) CountIPAssets(ctx context.Context, opts ListOptions) (int64, error) {
+	defer s.rlock(ctx)()
+	if opts.Deleted {
+		return countRows(s.deletedIPAssets, opts), nil
+	}
+	return countRows(s.ipAssets, opts), nil
+}
+
+func (s *memoryStore) UpdateIPAsset(ctx context.Context, ipAsset *IP_Asset, fields ...string) (*IP_Asset, error) {
+	defer s.lock(ctx, table(&s.ipAssets))()
+	patch := *ipAsset
+	patch.UpdatedAt = time.Now()
+	return updateRow(s.ipAssets, ipAsset.RegistrationNumber, &patch, fields)
+}
+
+func (s *memoryStore) DeleteIPAsset(ctx context.Context, registrationNumber string, version int64) error {
+	defer s.lock(ctx, table(&s.ipAssets), table(&s.deletedIPAssets))()
+	return trashRow(s.ipAssets, s.deletedIPAssets, registrationNumber, version)
+}
+
+func (s *memoryStore) RestoreIPAsset(ctx context.Context, registrationNumber string) (*IP_Asset, error) {
+	defer s.lock(ctx, table(&s.ipAssets), table(&s.deletedIPAssets))()
+	return restoreRow(s.ipAssets, s.deletedIPAssets, registrationNumber)
+}
+
+// Publication
+func (s *memoryStore) CreatePublication(ctx context.Context, publication *Publication) error {
+	defer s.lock(ctx, table(&s.publications))()
+	publication.Version = 1
+	publication.CreatedAt = time.Now()
+	publication.UpdatedAt = publication.CreatedAt
+	return insertLiveRow(s.publications, s.deletedPublications, publication.PublicationID, *publication)
+}
+
+func (s *memoryStore) GetPublication(ctx context.Context, id string) (*Publication, error) {
+	defer s.rlock(ctx)()
+	return getRow(s.publications, id)
+}
+
+func (s *memoryStore) ListPublications(ctx context.Context, opts ListOptions) ([]Publication, error) {
+	defer s.rlock(ctx)()
+	if opts.Deleted {
+		return listRows(s.deletedPublications, opts), nil
+	}
+	return listRows(s.publications, opts), nil
+}
+
+func (s *memoryStore) CountPublications(ctx context.Context, opts ListOptions) (int64, error) {
+	defer s.rlock(ctx)()
+	if opts.Deleted {
+		return countRows(s.deletedPublications, opts), nil
+	}
+	return countRows(s.publications, opts), nil
+}
+
+func (s *memoryStore) UpdatePublication(ctx context.Context, publication *Publication, fields ...string) (*Publication, error) {
+	defer s.lock(ctx, table(&s.publications))()
+	patch := *publication
+	patch.UpdatedAt = time.Now()
+	return updateRow(s.publications, publication.PublicationID, &patch, fields)
+}
+
+func (s *memoryStore) DeletePublication(ctx context.Context, id string, version int64) error {
+	defer s.lock(ctx, table(&s.publications), table(&s.deletedPublications))()
+	return trashRow(s.publications, s.deletedPublications, id, version)
+}
+
+func (s *memoryStore) RestorePublication(ctx context.Context, id string) (*Publication, error) {
+	defer s.lock(ctx, table(&s.publications), table(&s.deletedPublications))()
+	return restoreRow(s.publications, s.deletedPublications, id)
+}
+
+// User
+func (s *memoryStore) CreateUser(ctx context.Context, user *User) error {
+	defer s.lock(ctx, table(&s.users), field(&s.lastUserID))()
+	if user.UserID == 0 {
+		user.UserID = s.lastUserID + 1
+	}
+	user.Version = 1
+	user.CreatedAt = time.Now()
+	user.UpdatedAt = user.CreatedAt
+	if err := insertRow(s.users, user.UserID, *user); err != nil {
+		return err
+	}
+	if user.UserID > s.lastUserID {
+		s.lastUserID = user.UserID
+	}
+	return nil
+}
+
+func (s *memoryStore) GetUser(ctx context.Context, id int32) (*User, error) {
+	defer s.rlock(ctx)()
+	return getRow(s.users, id)
+}
+
+func (s *memoryStore) GetUserByLogin(ctx context.Context, login string) (*User, error) {
+	defer s.rlock(ctx)()
+	var match *User
+	for _, user := range s.users {
+		if (user.Email == login || user.SRCode == login) && (match == nil || user.UserID < match.UserID) {
+			u := user
+			match = &u
+		}
+	}
+	if match == nil {
+		return nil, ErrNotFound
+	}
+	return match, nil
+}
+
+func (s *memoryStore) ListUsers(ctx context.Context, opts ListOptions) ([]User, error) {
+	defer s.rlock(ctx)()
+	return listRows(s.users, opts), nil
+}
+
+func (s *memoryStore) CountUsers(ctx context.Context, opts ListOptions) (int64, error) {
+	defer s.rlock(ctx)()
+	return countRows(s.users, opts), nil
+}
+
+func (s *memoryStore) UpdateUser(ctx context.Context, user *User, fields ...string) (*User, error) {
+	defer s.lock(ctx, table(&s.users))()
+	patch := *user
+	patch.UpdatedAt = time.Now()
+	return updateRow(s.users, user.UserID, &patch, fields)
+}
+
+func (s *memoryStore) SetUserPassword(ctx context.Context, id int32, password string) error {
+	defer s.lock(ctx, table(&s.users))()
+	user, ok := s.users[id]
+	if !ok {
+		return ErrNotFound
+	}
+	user.Password = password
+	s.users[id] = user
+	return nil
+}
+
+func (s *memoryStore) DeleteUser(ctx context.Context, id int32, version int64) error {
+	defer s.lock(ctx, table(&s.users))()
+	return deleteRow(s.users, id, version)
+}
+
+// Log
+func (s *memoryStore) CreateLog(ctx context.Context, log *Log) error {
+	defer s.lock(ctx, table(&s.logs), field(&s.logHead))()
+	if _, ok := s.logs[log.LogID]; ok {
+		return ErrAlreadyExists
+	}
+	log.CreatedAt = time.Now()
+	log.UpdatedAt = log.CreatedAt
+	chainLog(log, &s.logHead)
+	s.logHead = *log
+	return insertRow(s.logs, log.LogID, *log)
+}
+
+func (s *memoryStore) GetLog(ctx context.Context, id string) (*Log, error) {
+	defer s.rlock(ctx)()
+	return getRow(s.logs, id)
+}
+
+func (s *memoryStore) ListLogs(ctx context.Context, opts ListOptions) ([]Log, error) {
+	defer s.rlock(ctx)()
+	return listRows(s.logs, opts), nil
+}
+
+func (s *memoryStore) CountLogs(ctx context.Context, opts ListOptions) (int64, error) {
+	defer s.rlock(ctx)()
+	return countRows(s.logs, opts), nil
+}
+
+// Author links
+func (s *memoryStore) LinkAuthor(ctx context.Context, kind recordKind, link AuthorLink) error {
+	defer s.lock(ctx, table(&s.authorLinks))()
+	if !s.recordExists(kind, link.RecordID) {
+		return ErrNotFound
+	}
+	if _, ok := s.authors[link.AuthorID]; !ok {
+		return ErrNotFound
+	}
+	if link.AuthorOrder == 0 {
+		for key, l := range s.authorLinks {
+			if key.kind == kind && key.recordID == link.RecordID && key.authorID != link.AuthorID && l.AuthorOrder > link.AuthorOrder {
+				link.AuthorOrder = l.AuthorOrder
+			}
+		}
+		link.AuthorOrder++
+	}
+	s.authorLinks[authorLinkKey{kind, link.RecordID, link.AuthorID}] = link
+	return nil
+}
+
+func (s *memoryStore) UnlinkAuthor(ctx context.Context, kind recordKind, recordID, authorID string) error {
+	defer s.lock(ctx, table(&s.authorLinks))()
+	key := authorLinkKey{kind, recordID, authorID}
+	if _, ok := s.authorLinks[key]; !ok {
+		return ErrNotFound
+	}
+	delete(s.authorLinks, key)
+	return nil
+}
+
+func (s *memoryStore) LinkedAuthors(ctx context.Context, kind recordKind, recordIDs []string) (map[string][]LinkedAuthor, error) {
+	defer s.rlock(ctx)()
+	wanted := map[string]bool{}
+	for _, id := range recordIDs {
+		wanted[id] = true
+	}
+	linked := map[string][]LinkedAuthor{}
+	for key, l := range s.authorLinks {
+		author, ok := s.authors[key.authorID]
+		if key.kind != kind || !wanted[key.recordID] || !ok {
+			continue
+		}
+		linked[key.recordID] = append(linked[key.recordID], LinkedAuthor{
+			Author:      author,
+			RecordID:    l.RecordID,
+			AuthorOrder: l.AuthorOrder,
+			Role:        l.Role,
+		})
+	}
+	for _, authors := range linked {
+		sort.Slice(authors, func(i, j int) bool {
+			if authors[i].AuthorOrder != authors[j].AuthorOrder {
+				return authors[i].AuthorOrder < authors[j].AuthorOrder
+			}
+			return authors[i].Author.ID < authors[j].Author.ID
+		})
+	}
+	return linked, nil
+}
+
+func (s *memoryStore) AuthorPublications(ctx context.Context, authorID string) ([]Publication, error) {
+	defer s.rlock(ctx)()
+	publications := []Publication{}
+	for key := range s.authorLinks {
+		if publication, ok := s.publications[key.recordID]; ok && key.kind == kindPublication && key.authorID == authorID {
+			publications = append(publications, publication)
+		}
+	}
+	sort.Slice(publications, func(i, j int) bool {
+		a, b := timeValue(publications[i].DatePublished), timeValue(publications[j].DatePublished)
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return publications[i].PublicationID < publications[j].PublicationID
+	})
+	return publications, nil
+}
+
+func (s *memoryStore) AuthorIPAssets(ctx context.Context, authorID string) ([]IP_Asset, error) {
+	defer s.rlock(ctx)()
+	ipAssets := []IP_Asset{}
+	for key := range s.authorLinks {
+		if ipAsset, ok := s.ipAssets[key.recordID]; ok && key.kind == kindIPAsset && key.authorID == authorID {
+			ipAssets = append(ipAssets, ipAsset)
+		}
+	}
+	sort.Slice(ipAssets, func(i, j int) bool {
+		a, b := timeValue(ipAssets[i].DateRegistered), timeValue(ipAssets[j].DateRegistered)
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return ipAssets[i].RegistrationNumber < ipAssets[j].RegistrationNumber
+	})
+	return ipAssets, nil
+}
+
+func (s *memoryStore) recordExists(kind recordKind, id string) bool {
+	var ok bool
+	switch kind {
+	case kindPublication:
+		_, ok = s.publications[id]
+	case kindIPAsset:
+		_, ok = s.ipAssets[id]
+	}
+	return ok
+}
+
+// dropAuthorLinks removes the links of a purged record.
+func (s *memoryStore) dropAuthorLinks(kind recordKind, recordID string) {
+	for key := range s.authorLinks {
+		if key.kind == kind && key.recordID == recordID {
+			delete(s.authorLinks, key)
+		}
+	}
+}
+
+// Trash
+func (s *memoryStore) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	defer s.lock(ctx, table(&s.deletedAuthors), table(&s.deletedIPAssets), table(&s.deletedPublications), table(&s.authorLinks), table(&s.revisions))()
+	var n int64
+	for _, id := range purgeRows(s.deletedPublications, before) {
+		s.dropAuthorLinks(kindPublication, id)
+		delete(s.revisions, revisionKey{entityPublication, id})
+		n++
+	}
+	for _, registrationNumber := range purgeRows(s.deletedIPAssets, before) {
+		s.dropAuthorLinks(kindIPAsset, registrationNumber)
+		delete(s.revisions, revisionKey{entityIPAsset, registrationNumber})
+		n++
+	}
+	for _, id := range purgeRows(s.deletedAuthors, before) {
+		for key := range s.authorLinks {
+			if key.authorID == id {
+				delete(s.authorLinks, key)
+			}
+		}
+		delete(s.revisions, revisionKey{entityAuthor, id})
+		n++
+	}
+	return n, nil
+}
+
+// Revisions
+func (s *memoryStore) CreateRevision(ctx context.Context, rev *Revision) error {
+	defer s.lock(ctx, table(&s.revisions))()
+	key := revisionKey{rev.Entity, rev.EntityID}
+	rev.Revision = int32(len(s.revisions[key]) + 1)
+	if rev.CreatedAt.IsZero() {
+		rev.CreatedAt = time.Now()
+	}
+	s.revisions[key] = append(s.revisions[key], *rev)
+	return nil
+}
+
+func (s *memoryStore) GetRevision(ctx context.Context, entity, entityID string, revision int32) (*Revision, error) {
+	defer s.rlock(ctx)()
+	revs := s.revisions[revisionKey{entity, entityID}]
+	if revision < 1 || int(revision) > len(revs) {
+		return nil, ErrNotFound
+	}
+	rev := revs[revision-1]
+	return &rev, nil
+}
+
+func (s *memoryStore) ListRevisions(ctx context.Context, entity, entityID string) ([]Revision, error) {
+	defer s.rlock(ctx)()
+	return append([]Revision{}, s.revisions[revisionKey{entity, entityID}]...), nil
+}
+
+// Vocabularies
+func (s *memoryStore) ListVocabulary(ctx context.Context, vocabulary string) ([]VocabularyTerm, error) {
+	defer s.rlock(ctx)()
+	terms := []VocabularyTerm{}
+	for _, term := range s.vocabulary {
+		if vocabulary == "" || term.Vocabulary == vocabulary {
+			terms = append(terms, term)
+		}
+	}
+	sortVocabularyTerms(terms)
+	return terms, nil
+}
+
+func (s *memoryStore) GetVocabularyTerm(ctx context.Context, vocabulary, value string) (*VocabularyTerm, error) {
+	defer s.rlock(ctx)()
+	term, ok := s.vocabulary[vocabularyKey{vocabulary, value}]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &term, nil
+}
+
+func (s *memoryStore) CreateVocabularyTerm(ctx context.Context, term *VocabularyTerm) error {
+	defer s.lock(ctx, table(&s.vocabulary))()
+	key := vocabularyKey{term.Vocabulary, term.Value}
+	if _, ok := s.vocabulary[key]; ok {
+		return ErrAlreadyExists
+	}
+	term.CreatedAt = time.Now()
+	term.UpdatedAt = term.CreatedAt
+	s.vocabulary[key] = *term
+	return nil
+}
+
+func (s *memoryStore) UpdateVocabularyTerm(ctx context.Context, term *VocabularyTerm, fields ...string) (*VocabularyTerm, error) {
+	defer s.lock(ctx, table(&s.vocabulary))()
+	key := vocabularyKey{term.Vocabulary, term.Value}
+	row, ok := s.vocabulary[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	patch := *term
+	patch.UpdatedAt = time.Now()
+	if len(fields) > 0 {
+		mergeFields(&row, &patch, append([]string{"UpdatedAt"}, fields...))
+	} else {
+		mergeNonZero(&row, &patch)
+	}
+	s.vocabulary[key] = row
+	return &row, nil
+}
+
+func (s *memoryStore) DeleteVocabularyTerm(ctx context.Context, vocabulary, value string) error {
+	defer s.lock(ctx, table(&s.vocabulary))()
+	key := vocabularyKey{vocabulary, value}
+	if _, ok := s.vocabulary[key]; !ok {
+		return ErrNotFound
+	}
+	delete(s.vocabulary, key)
+	return nil
+}
+
+func (s *memoryStore) VocabularyViolations(ctx context.Context, vocabulary string) ([]VocabularyViolation, error) {
+	defer s.rlock(ctx)()
+	violations := []VocabularyViolation{}
+	for _, f := range checkedVocabularyFields(vocabulary) {
+		allowed := map[string]bool{}
+		for key := range s.vocabulary {
+			if key.vocabulary == f.name {
+				allowed[key.value] = true
+			}
+		}
+		if len(allowed) == 0 && !strictVocabularies[f.name] {
+			continue
+		}
+		var rows []VocabularyViolation
+		switch f.entity {
+		case entityAuthor:
+			rows = outOfVocabulary(s.authors, f, allowed)
+		case entityIPAsset:
+			rows = outOfVocabulary(s.ipAssets, f, allowed)
+		case entityPublication:
+			rows = outOfVocabulary(s.publications, f, allowed)
+		}
+		sort.Slice(rows, func(i, j int) bool { return rows[i].EntityID < rows[j].EntityID })
+		violations = append(violations, rows...)
+	}
+	return violations, nil
+}
+
+// outOfVocabulary lists the rows whose field f is set to a value that allowed
+// does not contain.
+func outOfVocabulary[V any](rows map[string]V, f vocabularyField, allowed map[string]bool) []VocabularyViolation {
+	var violations []VocabularyViolation
+	for id, row := range rows {
+		value := reflect.ValueOf(row).FieldByName(f.goField).String()
+		if value != "" && !allowed[value] {
+			violations = append(violations, VocabularyViolation{Vocabulary: f.name, Entity: f.entity, EntityID: id, Value: value})
+		}
+	}
+	return violations
+}
+
+// Search
+func (s *memoryStore) Search(ctx context.Context, query string, limit int) ([]SearchHit, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	defer s.rlock(ctx)()
+
+	var hits []SearchHit
+	for _, p := range s.publications {
+		fields := publicationSearchFields(&p)
+		if rank, ok := matchTerms(terms, fields); ok {
+			hits = append(hits, SearchHit{
+				Kind:    searchKindPublication,
+				ID:      p.PublicationID,
+				Title:   p.TitleOfPaper,
+				Snippet: highlight(terms, fields),
+				Rank:    rank,
+			})
+		}
+	}
+	for _, a := range s.ipAssets {
+		fields := ipAssetSearchFields(&a)
+		if rank, ok := matchTerms(terms, fields); ok {
+			hits = append(hits, SearchHit{
+				Kind:    searchKindIPAsset,
+				ID:      a.RegistrationNumber,
+				Title:   a.TitleOfWork,
+				Snippet: highlight(terms, fields),
+				Rank:    rank,
+			})
+		}
+	}
+	return sortSearchHits(hits, limit), nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryTransaction(t *testing.T) {
+	store := newMemoryStore()
+	ctx := context.Background()
+	errFailed := errors.New("failed")
+
+	err := store.Transaction(ctx, func(ctx context.Context) error {
+		if err := store.CreateAuthor(ctx, &Author{ID: "kept"}); err != nil {
+			return err
+		}
+		err := store.Transaction(ctx, func(ctx context.Context) error {
+			if err := store.CreateAuthor(ctx, &Author{ID: "undone"}); err != nil {
+				return err
+			}
+			return errFailed
+		})
+		if !errors.Is(err, errFailed) {
+			t.Errorf("inner transaction returned %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.GetAuthor(ctx, "kept"); err != nil {
+		t.Errorf("the outer transaction's write was lost: %v", err)
+	}
+	if _, err := store.GetAuthor(ctx, "undone"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("the failed inner transaction's write was kept: %v", err)
+	}
+
+	// A write by another call waits for a transaction instead of being
+	// undone by its rollback.
+	started, written := make(chan struct{}), make(chan error)
+	err = store.Transaction(ctx, func(txCtx context.Context) error {
+		close(started)
+		go func() { written <- store.CreateAuthor(ctx, &Author{ID: "outside"}) }()
+		if err := store.CreateAuthor(txCtx, &Author{ID: "inside"}); err != nil {
+			return err
+		}
+		time.Sleep(10 * time.Millisecond)
+		return errFailed
+	})
+	<-started
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("transaction returned %v", err)
+	}
+	if err := <-written; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.GetAuthor(ctx, "outside"); err != nil {
+		t.Errorf("the write outside the transaction was undone: %v", err)
+	}
+	if _, err := store.GetAuthor(ctx, "inside"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("the rolled back write was kept: %v", err)
+	}
+}
+
+func TestMemoryTransactionSavesWrittenTables(t *testing.T) {
+	store := newMemoryStore()
+	errFailed := errors.New("failed")
+	err := store.Transaction(context.Background(), func(ctx context.Context) error {
+		if err := store.CreateAuthor(ctx, &Author{ID: "a1"}); err != nil {
+			return err
+		}
+		if err := store.CreateUser(ctx, &User{Email: "juan@example.com"}); err != nil {
+			return err
+		}
+		if saved := store.tx(ctx).saved; len(saved) != 3 {
+			t.Errorf("saved %d tables, want the authors, the users and the last user ID", len(saved))
+		}
+		return errFailed
+	})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("transaction returned %v", err)
+	}
+	if len(store.authors) != 0 || len(store.users) != 0 || store.lastUserID != 0 {
+		t.Errorf("rolled back to %d authors, %d users and last user ID %d", len(store.authors), len(store.users), store.lastUserID)
+	}
+}
+
+func TestMemoryCreateKeepsTrashedKeys(t *testing.T) {
+	store := newMemoryStore()
+	ctx := context.Background()
+	if err := store.CreateIPAsset(ctx, &IP_Asset{RegistrationNumber: "REG-001"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DeleteIPAsset(ctx, "REG-001", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.CreateIPAsset(ctx, &IP_Asset{RegistrationNumber: "REG-001"}); !errors.Is(err, ErrAlreadyExists) {
+		t.Fatalf("creating over a trashed IP asset returned %v, want ErrAlreadyExists", err)
+	}
+	if _, err := store.RestoreIPAsset(ctx, "REG-001"); err != nil {
+		t.Fatal(err)
+	}
+}
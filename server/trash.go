@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"github.com/google/uuid"
+)
+
+// Deleting an author, IP asset or publication moves it to the trash: it gets
+// a deleted_at time and disappears from reads, searches and author lists, but
+// keeps its author links so that restoring it brings them back. The purger
+// hard-deletes records that have been in the trash longer than the
+// configured retention.
+
+func (s *server) ListDeletedAuthors(ctx context.Context, req *pb.ReadAuthorsRequest) (*pb.ReadAuthorsResponse, error) {
+	fmt.Println("List Deleted Authors")
+	return s.listAuthors(ctx, req, true)
+}
+
+func (s *server) RestoreAuthor(ctx context.Context, req *pb.RestoreAuthorRequest) (*pb.RestoreAuthorResponse, error) {
+	fmt.Println("Restore Author", req.GetAuthorId())
+	author, err := s.authors.RestoreAuthor(ctx, req.GetAuthorId())
+	if err != nil {
+		return nil, storeError(err, "deleted author", req.GetAuthorId())
+	}
+	return &pb.RestoreAuthorResponse{
+		Author: authorToProto(author),
+	}, nil
+}
+
+func (s *server) ListDeletedIP_Assets(ctx context.Context, req *pb.ReadIP_AssetsRequest) (*pb.ReadIP_AssetsResponse, error) {
+	fmt.Println("List Deleted IP_assets")
+	return s.listIPAssets(ctx, req, true)
+}
+
+func (s *server) RestoreIP_Asset(ctx context.Context, req *pb.RestoreIP_AssetRequest) (*pb.RestoreIP_AssetResponse, error) {
+	fmt.Println("Restore IP_asset", req.GetRegistrationNumber())
+	ipAsset, err := s.ipAssets.RestoreIPAsset(ctx, req.GetRegistrationNumber())
+	if err != nil {
+		return nil, storeError(err, "deleted IP_asset", req.GetRegistrationNumber())
+	}
+	res := ipAssetToProto(ipAsset)
+	if err := s.withIPAssetAuthors(ctx, res); err != nil {
+		return nil, err
+	}
+	return &pb.RestoreIP_AssetResponse{
+		IpAsset: res,
+	}, nil
+}
+
+func (s *server) ListDeletedPublications(ctx context.Context, req *pb.ReadPublicationsRequest) (*pb.ReadPublicationsResponse, error) {
+	fmt.Println("List Deleted Publications")
+	return s.listPublications(ctx, req, true)
+}
+
+func (s *server) RestorePublication(ctx context.Context, req *pb.RestorePublicationRequest) (*pb.RestorePublicationResponse, error) {
+	fmt.Println("Restore Publication", req.GetPublicationId())
+	publication, err := s.publications.RestorePublication(ctx, req.GetPublicationId())
+	if err != nil {
+		return nil, storeError(err, "deleted publication", req.GetPublicationId())
+	}
+	res := publicationToProto(publication)
+	if err := s.withPublicationAuthors(ctx, res); err != nil {
+		return nil, err
+	}
+	return &pb.RestorePublicationResponse{
+		Publication: res,
+	}, nil
+}
+
+// purgeTrash purges expired trash once per interval until ctx is done.
+func (s *server) purgeTrash(ctx context.Context, cfg TrashConfig) {
+	ticker := time.NewTicker(time.Duration(cfg.PurgeInterval))
+	defer ticker.Stop()
+	for {
+		if err := s.purgeExpired(ctx, time.Duration(cfg.Retention)); err != nil {
+			log.Printf("Purging the trash: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeExpired hard-deletes the records trashed more than retention ago and
+// logs the purge when it removed any, in one transaction.
+func (s *server) purgeExpired(ctx context.Context, retention time.Duration) error {
+	before := time.Now().Add(-retention)
+	return s.tx.Transaction(ctx, func(ctx context.Context) error {
+		n, err := s.trash.PurgeDeleted(ctx, before)
+		if err != nil || n == 0 {
+			return err
+		}
+		log.Printf("Purged %d records deleted before %s", n, before.UTC().Format(time.RFC3339))
+		return s.logs.CreateLog(ctx, &Log{
+			LogID:       uuid.New().String(),
+			DateTime:    logTime(),
+			Activity:    actionPurge,
+			Description: fmt.Sprintf("purged %d records deleted before %s", n, before.UTC().Format(time.RFC3339)),
+			Method:      "PurgeDeleted",
+			Changes:     "{}",
+		})
+	})
+}
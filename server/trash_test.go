@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+)
+
+func TestTrash(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as(roleAdmin)
+	created, err := call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetPublication().GetPublicationId()
+	deleteAndList := func() []*pb.Publication {
+		t.Helper()
+		if _, err := call(ctx, ts, "DeletePublication", (*server).DeletePublication, &pb.DeletePublicationRequest{PublicationId: id}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := call(ctx, ts, "GetPublication", (*server).GetPublication, &pb.ReadPublicationRequest{PublicationId: id}); err == nil {
+			t.Fatal("read a trashed publication")
+		}
+		deleted, err := call(ctx, ts, "ListDeletedPublications", (*server).ListDeletedPublications, &pb.ReadPublicationsRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return deleted.GetPublications()
+	}
+
+	if trash := deleteAndList(); len(trash) != 1 || trash[0].GetPublicationId() != id || trash[0].GetDeletedAt() == "" {
+		t.Fatalf("trash holds %v", trash)
+	}
+	if _, err := call(ctx, ts, "RestorePublication", (*server).RestorePublication, &pb.RestorePublicationRequest{PublicationId: id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := call(ctx, ts, "GetPublication", (*server).GetPublication, &pb.ReadPublicationRequest{PublicationId: id}); err != nil {
+		t.Fatalf("reading a restored publication: %v", err)
+	}
+
+	deleteAndList()
+	if err := ts.purgeExpired(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := call(ctx, ts, "RestorePublication", (*server).RestorePublication, &pb.RestorePublicationRequest{PublicationId: id}); err == nil {
+		t.Error("restored a purged publication")
+	}
+}
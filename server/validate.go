@@ -0,0 +1,448 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Every request is validated before its handler runs. Validation covers what
+// a request must hold on its own, required fields and field formats; whether
+// the records it names exist is left to the handlers. All violations of a
+// request are reported together, by field path, e.g. "publication.quartile".
+
+// violations collects the invalid fields of a request.
+type violations []*errdetails.BadRequest_FieldViolation
+
+func (v *violations) add(field, description string) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+}
+
+// err returns InvalidArgument with a BadRequest listing the violations, or
+// nil when there are none.
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(v))
+	for i, f := range v {
+		msgs[i] = f.Field + ": " + f.Description
+	}
+	return withDetails(status.New(codes.InvalidArgument, strings.Join(msgs, "; ")), &errdetails.BadRequest{FieldViolations: v})
+}
+
+func (v *violations) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.add(field, "is required")
+	}
+}
+
+func (v *violations) email(field, value string) {
+	if value == "" {
+		return
+	}
+	if addr, err := mail.ParseAddress(value); err != nil || addr.Address != value {
+		v.add(field, "must be an email address")
+	}
+}
+
+func (v *violations) url(field, value string) {
+	if value == "" {
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.add(field, "must be an http or https URL")
+	}
+}
+
+// date checks a calendar date. Records often only know the year or the
+// month, so the day, or the month and day, may be zero; a date with a zero
+// year is unset.
+func (v *violations) date(field string, d *date.Date) {
+	year, month, day := d.GetYear(), d.GetMonth(), d.GetDay()
+	switch {
+	case year == 0 && month == 0 && day == 0:
+	case year < 1 || year > 9999:
+		v.add(field+".year", "must be from 1 to 9999")
+	case month < 0 || month > 12 || (month == 0 && day != 0):
+		v.add(field+".month", "must be from 1 to 12, or 0 with a zero day")
+	case day < 0 || day > 31 || (day > 0 && time.Date(int(year), time.Month(month), int(day), 0, 0, 0, 0, time.UTC).Day() != int(day)):
+		v.add(field+".day", "must be a day of the month, or 0")
+	}
+}
+
+func (v *violations) nonNegative(field string, n int64) {
+	if n < 0 {
+		v.add(field, "must not be negative")
+	}
+}
+
+func (v *violations) positive(field string, n int64) {
+	if n <= 0 {
+		v.add(field, "must be positive")
+	}
+}
+
+// sdgNumbers checks a comma-separated list of UN Sustainable Development
+// Goals, each 1 to 17 with an optional "SDG" prefix, e.g. "SDG 4, 9".
+func (v *violations) sdgNumbers(field, value string) {
+	if value == "" {
+		return
+	}
+	for _, goal := range strings.Split(value, ",") {
+		goal = strings.TrimSpace(goal)
+		if len(goal) >= 3 && strings.EqualFold(goal[:3], "SDG") {
+			goal = strings.TrimSpace(goal[3:])
+		}
+		if n, err := strconv.Atoi(goal); err != nil || n < 1 || n > 17 {
+			v.add(field, "must list SDG numbers from 1 to 17, separated by commas")
+			return
+		}
+	}
+}
+
+func (v *violations) role(field, value string) {
+	switch value {
+	case "", roleMainAuthor, roleCoAuthor, roleInventor:
+	default:
+		v.add(field, fmt.Sprintf("must be %s, %s or %s", roleMainAuthor, roleCoAuthor, roleInventor))
+	}
+}
+
+func (v *violations) accountType(field, value string) {
+	if value == "" {
+		return
+	}
+	switch normalizeRole(value) {
+	case roleAdmin, roleResearchOffice, roleFaculty, roleStudent:
+	default:
+		v.add(field, "must be Admin, Research Office, Faculty or Student")
+	}
+}
+
+func (v *violations) revisable(field, value string) {
+	if !revisable[value] {
+		v.add(field, fmt.Sprintf("must be %s, %s or %s", entityAuthor, entityIPAsset, entityPublication))
+	}
+}
+
+func (v *violations) vocabulary(field, value string) {
+	if !isVocabulary(value) {
+		v.add(field, fmt.Sprintf("%q is not a vocabulary", value))
+	}
+}
+
+func (v *violations) batch(field string, n int, mode pb.BatchMode) {
+	if n == 0 {
+		v.add(field, "is required")
+	}
+	if n > maxBatchSize {
+		v.add(field, fmt.Sprintf("must have at most %d items", maxBatchSize))
+	}
+	if _, ok := pb.BatchMode_name[int32(mode)]; !ok {
+		v.add("mode", fmt.Sprintf("unknown batch mode %d", mode))
+	}
+}
+
+// requiredFunc reports whether the field at a path of a record must be set:
+// every required field on create, and on update only those its mask names,
+// since an update without a mask leaves empty fields as they are.
+type requiredFunc func(path string) bool
+
+func onCreate(string) bool { return true }
+
+func onUpdate(mask *fieldmaskpb.FieldMask) requiredFunc {
+	return func(path string) bool {
+		for _, p := range mask.GetPaths() {
+			if p == path {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func validateAuthor(v *violations, prefix string, a *pb.Author, required requiredFunc) {
+	if a == nil {
+		v.add(prefix, "is required")
+		return
+	}
+	if required("author_name") {
+		v.required(prefix+".author_name", a.GetAuthorName())
+	}
+	v.email(prefix+".email", a.GetEmail())
+	v.nonNegative(prefix+".version", a.GetVersion())
+}
+
+func validateIPAsset(v *violations, prefix string, a *pb.IP_Asset, required requiredFunc) {
+	if a == nil {
+		v.add(prefix, "is required")
+		return
+	}
+	if required("title_of_work") {
+		v.required(prefix+".title_of_work", a.GetTitleOfWork())
+	}
+	v.date(prefix+".date_of_creation", a.GetDateOfCreation())
+	v.date(prefix+".date_registered", a.GetDateRegistered())
+	v.url(prefix+".hyperlink", a.GetHyperlink())
+	v.nonNegative(prefix+".version", a.GetVersion())
+}
+
+func validatePublication(v *violations, prefix string, p *pb.Publication, required requiredFunc) {
+	if p == nil {
+		v.add(prefix, "is required")
+		return
+	}
+	if required("title_of_paper") {
+		v.required(prefix+".title_of_paper", p.GetTitleOfPaper())
+	}
+	v.date(prefix+".date_published", p.GetDatePublished())
+	v.sdgNumbers(prefix+".sdg_no", p.GetSdgNo())
+	v.nonNegative(prefix+".number_of_citation", int64(p.GetNumberOfCitation()))
+	v.nonNegative(prefix+".version", p.GetVersion())
+}
+
+func validateUser(v *violations, prefix string, u *pb.User, required requiredFunc) {
+	if u == nil {
+		v.add(prefix, "is required")
+		return
+	}
+	if required("email") {
+		v.required(prefix+".email", u.GetEmail())
+	}
+	v.email(prefix+".email", u.GetEmail())
+	v.accountType(prefix+".account_type", u.GetAccountType())
+	v.nonNegative(prefix+".version", u.GetVersion())
+}
+
+func validateLog(v *violations, prefix string, l *pb.Log) {
+	if l == nil {
+		v.add(prefix, "is required")
+		return
+	}
+	v.required(prefix+".activity", l.GetActivity())
+}
+
+func validateVocabularyTerm(v *violations, prefix string, t *pb.VocabularyTerm) {
+	if t == nil {
+		v.add(prefix, "is required")
+		return
+	}
+	v.vocabulary(prefix+".vocabulary", t.GetVocabulary())
+	v.required(prefix+".value", t.GetValue())
+	v.nonNegative(prefix+".position", int64(t.GetPosition()))
+}
+
+// validateRequest checks a request message; every request of RMS.proto has
+// a case, even when it has nothing to check.
+func validateRequest(req any) error {
+	var v violations
+	switch r := req.(type) {
+	case *pb.CreateAuthorRequest:
+		validateAuthor(&v, "author", r.GetAuthor(), onCreate)
+	case *pb.ReadAuthorRequest:
+		v.required("author_id", r.GetAuthorId())
+	case *pb.UpdateAuthorRequest:
+		validateAuthor(&v, "author", r.GetAuthor(), onUpdate(r.GetUpdateMask()))
+		v.required("author.author_id", r.GetAuthor().GetAuthorId())
+	case *pb.DeleteAuthorRequest:
+		v.required("author_id", r.GetAuthorId())
+		v.nonNegative("version", r.GetVersion())
+	case *pb.RestoreAuthorRequest:
+		v.required("author_id", r.GetAuthorId())
+
+	case *pb.CreateIP_AssetRequest:
+		validateIPAsset(&v, "ip_asset", r.GetIpAsset(), onCreate)
+	case *pb.ReadIP_AssetRequest:
+		v.required("registration_number", r.GetRegistrationNumber())
+	case *pb.UpdateIP_AssetRequest:
+		validateIPAsset(&v, "ip_asset", r.GetIpAsset(), onUpdate(r.GetUpdateMask()))
+		v.required("ip_asset.registration_number", r.GetIpAsset().GetRegistrationNumber())
+	case *pb.DeleteIP_AssetRequest:
+		v.required("registration_number", r.GetRegistrationNumber())
+		v.nonNegative("version", r.GetVersion())
+	case *pb.RestoreIP_AssetRequest:
+		v.required("registration_number", r.GetRegistrationNumber())
+
+	case *pb.CreatePublicationRequest:
+		validatePublication(&v, "publication", r.GetPublication(), onCreate)
+	case *pb.ReadPublicationRequest:
+		v.required("publication_id", r.GetPublicationId())
+	case *pb.UpdatePublicationRequest:
+		validatePublication(&v, "publication", r.GetPublication(), onUpdate(r.GetUpdateMask()))
+		v.required("publication.publication_id", r.GetPublication().GetPublicationId())
+	case *pb.DeletePublicationRequest:
+		v.required("publication_id", r.GetPublicationId())
+		v.nonNegative("version", r.GetVersion())
+	case *pb.RestorePublicationRequest:
+		v.required("publication_id", r.GetPublicationId())
+
+	case *pb.CreateUserRequest:
+		validateUser(&v, "user", r.GetUser(), onCreate)
+		v.nonNegative("user.user_id", int64(r.GetUser().GetUserId()))
+	case *pb.ReadUserRequest:
+		v.positive("user_id", int64(r.GetUserId()))
+	case *pb.UpdateUserRequest:
+		validateUser(&v, "user", r.GetUser(), onUpdate(r.GetUpdateMask()))
+		v.positive("user.user_id", int64(r.GetUser().GetUserId()))
+	case *pb.DeleteUserRequest:
+		v.positive("user_id", int64(r.GetUserId()))
+		v.nonNegative("version", r.GetVersion())
+
+	case *pb.CreateLogRequest:
+		validateLog(&v, "log", r.GetLog())
+	case *pb.ReadLogRequest:
+		v.required("log_id", r.GetLogId())
+	case *pb.UpdateLogRequest, *pb.DeleteLogRequest:
+		// Rejected by their handlers: the log is append-only.
+
+	case *pb.ReadAuthorsRequest, *pb.ReadIP_AssetsRequest, *pb.ReadPublicationsRequest, *pb.ReadUsersRequest, *pb.ReadLogsRequest:
+		// newPager checks the filter, order_by and page token against the
+		// schema of the listed records.
+		v.nonNegative("page_size", int64(r.(listRequest).GetPageSize()))
+
+	case *pb.LinkPublicationAuthorRequest:
+		v.required("publication_id", r.GetPublicationId())
+		v.required("author_id", r.GetAuthorId())
+		v.nonNegative("author_order", int64(r.GetAuthorOrder()))
+		v.role("role", r.GetRole())
+	case *pb.UnlinkPublicationAuthorRequest:
+		v.required("publication_id", r.GetPublicationId())
+		v.required("author_id", r.GetAuthorId())
+	case *pb.LinkIP_AssetAuthorRequest:
+		v.required("registration_number", r.GetRegistrationNumber())
+		v.required("author_id", r.GetAuthorId())
+		v.nonNegative("author_order", int64(r.GetAuthorOrder()))
+		v.role("role", r.GetRole())
+	case *pb.UnlinkIP_AssetAuthorRequest:
+		v.required("registration_number", r.GetRegistrationNumber())
+		v.required("author_id", r.GetAuthorId())
+	case *pb.ListAuthorPublicationsRequest:
+		v.required("author_id", r.GetAuthorId())
+	case *pb.ListAuthorIP_AssetsRequest:
+		v.required("author_id", r.GetAuthorId())
+
+	case *pb.SearchRequest:
+		v.required("query", r.GetQuery())
+		v.nonNegative("page_size", int64(r.GetPageSize()))
+	case *pb.AuthenticateRequest:
+		v.required("login", r.GetLogin())
+		v.required("password", r.GetPassword())
+	case *pb.VerifyLogChainRequest, *pb.ExportLogCheckpointRequest:
+		// VerifyLogChain checks the signature of its checkpoint itself.
+
+	case *pb.ListRevisionsRequest:
+		v.revisable("entity", r.GetEntity())
+		v.required("entity_id", r.GetEntityId())
+	case *pb.DiffRevisionsRequest:
+		v.revisable("entity", r.GetEntity())
+		v.required("entity_id", r.GetEntityId())
+		v.positive("from_revision", int64(r.GetFromRevision()))
+		v.nonNegative("to_revision", int64(r.GetToRevision()))
+	case *pb.RevertToRevisionRequest:
+		v.revisable("entity", r.GetEntity())
+		v.required("entity_id", r.GetEntityId())
+		v.positive("revision", int64(r.GetRevision()))
+
+	case *pb.ListVocabularyRequest:
+		if r.GetVocabulary() != "" {
+			v.vocabulary("vocabulary", r.GetVocabulary())
+		}
+	case *pb.CreateVocabularyTermRequest:
+		validateVocabularyTerm(&v, "term", r.GetTerm())
+	case *pb.UpdateVocabularyTermRequest:
+		validateVocabularyTerm(&v, "term", r.GetTerm())
+	case *pb.DeleteVocabularyTermRequest:
+		v.vocabulary("vocabulary", r.GetVocabulary())
+		v.required("value", r.GetValue())
+	case *pb.ListVocabularyViolationsRequest:
+		if r.GetVocabulary() != "" {
+			v.vocabulary("vocabulary", r.GetVocabulary())
+		}
+
+	// Batched requests are validated one by one as they run.
+	case *pb.BatchCreateAuthorsRequest:
+		v.batch("requests", len(r.GetRequests()), r.GetMode())
+	case *pb.BatchUpdateAuthorsRequest:
+		v.batch("requests", len(r.GetRequests()), r.GetMode())
+	case *pb.BatchCreateIP_AssetsRequest:
+		v.batch("requests", len(r.GetRequests()), r.GetMode())
+	case *pb.BatchUpdateIP_AssetsRequest:
+		v.batch("requests", len(r.GetRequests()), r.GetMode())
+	case *pb.BatchCreatePublicationsRequest:
+		v.batch("requests", len(r.GetRequests()), r.GetMode())
+	case *pb.BatchUpdatePublicationsRequest:
+		v.batch("requests", len(r.GetRequests()), r.GetMode())
+	case *pb.BatchCreateUsersRequest:
+		v.batch("requests", len(r.GetRequests()), r.GetMode())
+	case *pb.BatchUpdateUsersRequest:
+		v.batch("requests", len(r.GetRequests()), r.GetMode())
+
+	// Imported rows are validated one by one as they run.
+	case *pb.ImportRecordsRequest:
+		if _, ok := importEntities[r.GetEntity()]; !ok {
+			v.add("entity", fmt.Sprintf("must be %s or %s", entityIPAsset, entityPublication))
+		}
+		if r.GetFormat() != "csv" && r.GetFormat() != "jsonl" {
+			v.add("format", "must be csv or jsonl")
+		}
+		if len(r.GetData()) == 0 {
+			v.add("data", "is required")
+		}
+		if _, ok := pb.BatchMode_name[int32(r.GetMode())]; !ok {
+			v.add("mode", fmt.Sprintf("unknown batch mode %d", r.GetMode()))
+		}
+	case *pb.ExportRecordsRequest:
+		if _, ok := exportColumns[r.GetEntity()]; !ok {
+			v.add("entity", fmt.Sprintf("must be %s, %s, %s, %s or %s", entityAuthor, entityIPAsset, entityPublication, entityUser, entityLog))
+		}
+		if r.GetFormat() != "csv" && r.GetFormat() != "jsonl" {
+			v.add("format", "must be csv or jsonl")
+		}
+	case *pb.ExportCitationsRequest:
+		switch r.GetFormat() {
+		case "bibtex", "ris", "csl-json":
+		default:
+			v.add("format", "must be bibtex, ris or csl-json")
+		}
+		if len(r.GetPublicationIds()) > maxPageSize {
+			v.add("publication_ids", fmt.Sprintf("must have at most %d items", maxPageSize))
+		}
+		if len(r.GetPublicationIds()) > 0 && (r.GetFilter() != "" || r.GetOrderBy() != "") {
+			v.add("filter", "cannot be combined with publication_ids")
+		}
+	case *pb.ImportCitationsRequest:
+		if r.GetFormat() != "bibtex" && r.GetFormat() != "ris" {
+			v.add("format", "must be bibtex or ris")
+		}
+		if len(r.GetData()) == 0 {
+			v.add("data", "is required")
+		}
+	}
+	return v.err()
+}
+
+// validate rejects invalid requests before they reach their handlers. It runs
+// after authorize, so callers learn nothing about RPCs they may not call.
+func (s *server) validate(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+	if err := s.checkVocabularies(ctx, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidate(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as(roleAdmin)
+
+	_, err := call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{SdgNo: "SDG 18", NumberOfCitation: -1},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+	var fields []string
+	for _, d := range status.Convert(err).Details() {
+		if d, ok := d.(*errdetails.BadRequest); ok {
+			for _, f := range d.GetFieldViolations() {
+				fields = append(fields, f.GetField())
+			}
+		}
+	}
+	want := []string{"publication.title_of_paper", "publication.sdg_no", "publication.number_of_citation"}
+	if len(fields) != len(want) {
+		t.Fatalf("violations of %v, want %v", fields, want)
+	}
+	for i := range want {
+		if fields[i] != want[i] {
+			t.Fatalf("violations of %v, want %v", fields, want)
+		}
+	}
+	if len(ts.store.logs) != 0 {
+		t.Error("logged a request that failed validation")
+	}
+
+	_, err = call(ctx, ts, "CreateAuthor", (*server).CreateAuthor, &pb.CreateAuthorRequest{
+		Author: &pb.Author{AuthorName: "Juan Dela Cruz", Email: "Juan <juan@example.com>"},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+
+	_, err = call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield", Quartile: "Q1", SdgNo: "SDG 2, 9"},
+	})
+	wantCode(t, err, codes.OK)
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Categorical fields take their values from controlled vocabularies, one per
+// field, which admins manage and front ends list for dropdowns. Creates and
+// updates are rejected when they set such a field to a value outside its
+// vocabulary. A vocabulary without terms allows any value, so that each one
+// can be enforced once it has been filled in, and the records written before
+// are listed by ListVocabularyViolations. Strict vocabularies, whose values
+// were always limited, allow no value while they have no terms.
+
+// VocabularyTerm is a value allowed for a categorical field.
+type VocabularyTerm struct {
+	Vocabulary string `gorm:"primarykey"`
+	Value      string `gorm:"primarykey"`
+	Label      string
+	Position   int32
+	CreatedAt  time.Time `gorm:"autoCreateTime:true"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime:true"`
+}
+
+// VocabularyViolation is a record whose value of a categorical field is not
+// in the field's vocabulary.
+type VocabularyViolation struct {
+	Vocabulary string
+	Entity     string
+	EntityID   string
+	Value      string
+}
+
+// vocabularyField is a categorical field. Vocabularies are named after the
+// proto and column name of their field.
+type vocabularyField struct {
+	name    string
+	entity  string
+	table   string
+	key     string
+	goField string
+}
+
+var vocabularyFields = []vocabularyField{
+	{"type_of_author", entityAuthor, "table_authors", "author_id", "TypeofAuthor"},
+	{"gender", entityAuthor, "table_authors", "author_id", "AuthorGender"},
+	{"status", entityIPAsset, "table_ipassets", "registration_number", "Status"},
+	{"class_of_work", entityIPAsset, "table_ipassets", "registration_number", "ClassOfWork"},
+	{"type_of_document", entityIPAsset, "table_ipassets", "registration_number", "TypeOfDocument"},
+	{"quartile", entityPublication, "table_publications", "publication_id", "Quartile"},
+	{"type_of_publication", entityPublication, "table_publications", "publication_id", "TypeOfPublication"},
+	{"funding_type", entityPublication, "table_publications", "publication_id", "FundingType"},
+	{"nature_of_funding", entityPublication, "table_publications", "publication_id", "NatureOfFunding"},
+}
+
+// strictVocabularies lists the vocabularies that allow no value while they
+// have no terms.
+var strictVocabularies = map[string]bool{
+	"quartile": true,
+}
+
+// seedVocabularyTerms are the terms a new store starts with. Migration 0012
+// inserts the same terms into Postgres.
+var seedVocabularyTerms = []VocabularyTerm{
+	{Vocabulary: "quartile", Value: "Q1", Label: "Q1", Position: 1},
+	{Vocabulary: "quartile", Value: "Q2", Label: "Q2", Position: 2},
+	{Vocabulary: "quartile", Value: "Q3", Label: "Q3", Position: 3},
+	{Vocabulary: "quartile", Value: "Q4", Label: "Q4", Position: 4},
+}
+
+// isVocabulary reports whether name is the vocabulary of a categorical field.
+func isVocabulary(name string) bool {
+	for _, f := range vocabularyFields {
+		if f.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkedVocabularyFields returns the categorical fields of one vocabulary,
+// or all of them when it is "".
+func checkedVocabularyFields(vocabulary string) []vocabularyField {
+	if vocabulary == "" {
+		return vocabularyFields
+	}
+	for _, f := range vocabularyFields {
+		if f.name == vocabulary {
+			return []vocabularyField{f}
+		}
+	}
+	return nil
+}
+
+// sortVocabularyTerms orders terms as ListVocabulary returns them.
+func sortVocabularyTerms(terms []VocabularyTerm) {
+	sort.Slice(terms, func(i, j int) bool {
+		a, b := terms[i], terms[j]
+		if a.Vocabulary != b.Vocabulary {
+			return a.Vocabulary < b.Vocabulary
+		}
+		if a.Position != b.Position {
+			return a.Position < b.Position
+		}
+		return a.Value < b.Value
+	})
+}
+
+// checkVocabularies rejects creates and updates of authors, IP assets and
+// publications that set a categorical field to a value outside its
+// vocabulary. Updates with a mask are only checked on the fields it names.
+func (s *server) checkVocabularies(ctx context.Context, req any) error {
+	var (
+		entity  string
+		record  proto.Message
+		written requiredFunc = onCreate
+	)
+	switch r := req.(type) {
+	case *pb.CreateAuthorRequest:
+		entity, record = entityAuthor, r.GetAuthor()
+	case *pb.UpdateAuthorRequest:
+		entity, record = entityAuthor, r.GetAuthor()
+		if len(r.GetUpdateMask().GetPaths()) > 0 {
+			written = onUpdate(r.GetUpdateMask())
+		}
+	case *pb.CreateIP_AssetRequest:
+		entity, record = entityIPAsset, r.GetIpAsset()
+	case *pb.UpdateIP_AssetRequest:
+		entity, record = entityIPAsset, r.GetIpAsset()
+		if len(r.GetUpdateMask().GetPaths()) > 0 {
+			written = onUpdate(r.GetUpdateMask())
+		}
+	case *pb.CreatePublicationRequest:
+		entity, record = entityPublication, r.GetPublication()
+	case *pb.UpdatePublicationRequest:
+		entity, record = entityPublication, r.GetPublication()
+		if len(r.GetUpdateMask().GetPaths()) > 0 {
+			written = onUpdate(r.GetUpdateMask())
+		}
+	default:
+		return nil
+	}
+
+	m := record.ProtoReflect()
+	values := map[string]string{}
+	for _, f := range vocabularyFields {
+		if f.entity != entity || !written(f.name) {
+			continue
+		}
+		if v := m.Get(m.Descriptor().Fields().ByName(protoreflect.Name(f.name))).String(); v != "" {
+			values[f.name] = v
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	terms, err := s.vocabularies.ListVocabulary(ctx, "")
+	if err != nil {
+		return storeError(err, "vocabulary", "")
+	}
+	allowed := map[string][]string{}
+	for _, t := range terms {
+		allowed[t.Vocabulary] = append(allowed[t.Vocabulary], t.Value)
+	}
+	var v violations
+	for _, f := range vocabularyFields {
+		value, ok := values[f.name]
+		if !ok || (len(allowed[f.name]) == 0 && !strictVocabularies[f.name]) {
+			continue
+		}
+		if suggestion, ok := vocabularyMatch(allowed[f.name], value); !ok {
+			desc := fmt.Sprintf("%q is not in the %s vocabulary", value, f.name)
+			if suggestion != "" {
+				desc += fmt.Sprintf("; did you mean %q?", suggestion)
+			}
+			v.add(entity+"."+f.name, desc)
+		}
+	}
+	return v.err()
+}
+
+// vocabularyMatch reports whether value is one of terms. When it is not, it
+// suggests the term that differs only in case and surrounding spaces.
+func vocabularyMatch(terms []string, value string) (suggestion string, ok bool) {
+	for _, t := range terms {
+		if t == value {
+			return "", true
+		}
+		if strings.EqualFold(t, strings.TrimSpace(value)) {
+			suggestion = t
+		}
+	}
+	return suggestion, false
+}
+
+func (s *server) ListVocabulary(ctx context.Context, req *pb.ListVocabularyRequest) (*pb.ListVocabularyResponse, error) {
+	fmt.Println("List Vocabulary", req.GetVocabulary())
+	terms, err := s.vocabularies.ListVocabulary(ctx, req.GetVocabulary())
+	if err != nil {
+		return nil, storeError(err, "vocabulary", req.GetVocabulary())
+	}
+	res := &pb.ListVocabularyResponse{Terms: []*pb.VocabularyTerm{}}
+	for i := range terms {
+		res.Terms = append(res.Terms, vocabularyTermToProto(&terms[i]))
+	}
+	return res, nil
+}
+
+func (s *server) CreateVocabularyTerm(ctx context.Context, req *pb.CreateVocabularyTermRequest) (*pb.CreateVocabularyTermResponse, error) {
+	fmt.Println("Create Vocabulary Term")
+	term := vocabularyTermFromProto(req.GetTerm())
+	if term.Label == "" {
+		term.Label = term.Value
+	}
+	if err := s.vocabularies.CreateVocabularyTerm(ctx, &term); err != nil {
+		return nil, storeError(err, "vocabulary term", term.Vocabulary+"/"+term.Value)
+	}
+
+	return &pb.CreateVocabularyTermResponse{
+		Term: vocabularyTermToProto(&term),
+	}, nil
+}
+
+func (s *server) UpdateVocabularyTerm(ctx context.Context, req *pb.UpdateVocabularyTermRequest) (*pb.UpdateVocabularyTermResponse, error) {
+	fmt.Println("Update Vocabulary Term")
+	reqTerm := vocabularyTermFromProto(req.GetTerm())
+	fields, err := maskFields(req.GetUpdateMask(), vocabularyTermMaskFields)
+	if err != nil {
+		return nil, err
+	}
+	if hasField(fields, "Label") && reqTerm.Label == "" {
+		reqTerm.Label = reqTerm.Value
+	}
+
+	term, err := s.vocabularies.UpdateVocabularyTerm(ctx, &reqTerm, fields...)
+	if err != nil {
+		return nil, storeError(err, "vocabulary term", reqTerm.Vocabulary+"/"+reqTerm.Value)
+	}
+
+	return &pb.UpdateVocabularyTermResponse{
+		Term: vocabularyTermToProto(term),
+	}, nil
+}
+
+func (s *server) DeleteVocabularyTerm(ctx context.Context, req *pb.DeleteVocabularyTermRequest) (*pb.DeleteVocabularyTermResponse, error) {
+	fmt.Println("Delete Vocabulary Term")
+	if err := s.vocabularies.DeleteVocabularyTerm(ctx, req.GetVocabulary(), req.GetValue()); err != nil {
+		return nil, storeError(err, "vocabulary term", req.GetVocabulary()+"/"+req.GetValue())
+	}
+
+	return &pb.DeleteVocabularyTermResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *server) ListVocabularyViolations(ctx context.Context, req *pb.ListVocabularyViolationsRequest) (*pb.ListVocabularyViolationsResponse, error) {
+	fmt.Println("List Vocabulary Violations", req.GetVocabulary())
+	violations, err := s.vocabularies.VocabularyViolations(ctx, req.GetVocabulary())
+	if err != nil {
+		return nil, storeError(err, "vocabulary", req.GetVocabulary())
+	}
+	res := &pb.ListVocabularyViolationsResponse{Violations: []*pb.VocabularyViolation{}}
+	for i := range violations {
+		res.Violations = append(res.Violations, vocabularyViolationToProto(&violations[i]))
+	}
+	return res, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"testing"
+
+	pb "example.com/go-grpc-crud-api/proto"
+	"google.golang.org/grpc/codes"
+)
+
+func TestVocabulary(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as(roleAdmin)
+
+	// Written before the vocabulary has terms, when any value goes.
+	if _, err := call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield", TypeOfPublication: "Blog"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for i, value := range []string{"Journal", "Conference"} {
+		if _, err := call(ctx, ts, "CreateVocabularyTerm", (*server).CreateVocabularyTerm, &pb.CreateVocabularyTermRequest{
+			Term: &pb.VocabularyTerm{Vocabulary: "type_of_publication", Value: value, Position: int32(i + 1)},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list, err := call(ctx, ts, "ListVocabulary", (*server).ListVocabulary, &pb.ListVocabularyRequest{Vocabulary: "type_of_publication"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if terms := list.GetTerms(); len(terms) != 2 || terms[0].GetValue() != "Journal" || terms[0].GetLabel() != "Journal" {
+		t.Fatalf("listed %v, want the terms by position, labelled by their values", terms)
+	}
+
+	_, err = call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Rice Yield in Batangas", TypeOfPublication: "Book"},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Rice Yield in Batangas", TypeOfPublication: "Conference"},
+	})
+	wantCode(t, err, codes.OK)
+
+	violations, err := call(ctx, ts, "ListVocabularyViolations", (*server).ListVocabularyViolations, &pb.ListVocabularyViolationsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := violations.GetViolations(); len(v) != 1 || v[0].GetVocabulary() != "type_of_publication" || v[0].GetValue() != "Blog" {
+		t.Fatalf("violations %v, want the publication written before the terms", v)
+	}
+
+	_, err = call(ctx, ts, "CreateVocabularyTerm", (*server).CreateVocabularyTerm, &pb.CreateVocabularyTermRequest{
+		Term: &pb.VocabularyTerm{Vocabulary: "title_of_paper", Value: "Rice"},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestVocabularyChecksQuartile(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := ts.as(roleAdmin)
+	_, err := call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield", Quartile: "Q9"},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield", Quartile: "q2"},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Deep Learning for Rice Yield", Quartile: "Q2"},
+	})
+	wantCode(t, err, codes.OK)
+
+	// Without terms, the quartile vocabulary allows no value.
+	for _, value := range []string{"Q1", "Q2", "Q3", "Q4"} {
+		if _, err := call(ctx, ts, "DeleteVocabularyTerm", (*server).DeleteVocabularyTerm, &pb.DeleteVocabularyTermRequest{Vocabulary: "quartile", Value: value}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = call(ctx, ts, "CreatePublication", (*server).CreatePublication, &pb.CreatePublicationRequest{
+		Publication: &pb.Publication{TitleOfPaper: "Mangrove Carbon Stocks", Quartile: "Q1"},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+	violations, err := call(ctx, ts, "ListVocabularyViolations", (*server).ListVocabularyViolations, &pb.ListVocabularyViolationsRequest{Vocabulary: "quartile"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := violations.GetViolations(); len(v) != 1 || v[0].GetValue() != "Q2" {
+		t.Errorf("violations %v, want the publication in Q2", v)
+	}
+}